@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrBlobNotFound is returned by a BlobStore for a URI it holds nothing under.
+var ErrBlobNotFound = errors.New("client: blob not found")
+
+// BlobStore keeps claim-checked payloads outside the queue.
+type BlobStore interface {
+	// Put stores data under key and returns the URI to reference it by.
+	Put(ctx context.Context, key string, data []byte) (string, error)
+	// Get returns the data stored under uri.
+	Get(ctx context.Context, uri string) ([]byte, error)
+	// Delete removes the data stored under uri.
+	Delete(ctx context.Context, uri string) error
+}
+
+// FileBlobStore is a BlobStore keeping each payload in a file under a
+// directory and referencing it by a file:// URI. Every process resolving the
+// URIs must see the same directory, and its path must be short enough for
+// the URIs to fit proto.MaxPayloadReferenceURI: with the 64-character keys
+// of ClaimCheckClient, at most 143 bytes.
+type FileBlobStore struct {
+	dir string
+}
+
+// NewFileBlobStore returns a FileBlobStore rooted at dir, creating it if needed.
+func NewFileBlobStore(dir string) (*FileBlobStore, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(abs, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileBlobStore{dir: abs}, nil
+}
+
+// Put writes data to a file named key. The write is atomic, so a concurrent
+// Get never sees a partial payload.
+func (s *FileBlobStore) Put(_ context.Context, key string, data []byte) (string, error) {
+	if key == "" || key != filepath.Base(key) || key == "." || key == ".." {
+		return "", fmt.Errorf("client: invalid blob key %q", key)
+	}
+	tmp, err := os.CreateTemp(s.dir, ".put-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	path := filepath.Join(s.dir, key)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", err
+	}
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String(), nil
+}
+
+// Get reads the file behind uri.
+func (s *FileBlobStore) Get(_ context.Context, uri string) ([]byte, error) {
+	path, err := s.path(uri)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrBlobNotFound, uri)
+	}
+	return data, err
+}
+
+// Delete removes the file behind uri.
+func (s *FileBlobStore) Delete(_ context.Context, uri string) error {
+	path, err := s.path(uri)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: %s", ErrBlobNotFound, uri)
+	}
+	return err
+}
+
+// path maps uri to a file, refusing anything outside the store directory.
+func (s *FileBlobStore) path(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return "", fmt.Errorf("client: not a file blob URI %q", uri)
+	}
+	path := filepath.Clean(filepath.FromSlash(u.Path))
+	if !strings.HasPrefix(path, s.dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("client: blob URI %q is outside %s", uri, s.dir)
+	}
+	return path, nil
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// DefaultClaimCheckThreshold is the payload size above which a
+// ClaimCheckClient built with a zero threshold moves payloads to its store.
+const DefaultClaimCheckThreshold = 256 << 10
+
+// ErrPayloadMismatch is returned when a downloaded payload does not match the
+// size or hash recorded in its PayloadReference.
+var ErrPayloadMismatch = errors.New("client: claim-checked payload does not match its reference")
+
+// ClaimCheckClient wraps a KokaqDataPlaneClient so large payloads travel
+// through a BlobStore. Enqueue uploads payloads over the threshold and sends
+// a payload_ref in their place; calls returning messages download referenced
+// payloads back into payload, leaving payload_ref set. Blobs are keyed by
+// content hash and are never deleted by the client.
+type ClaimCheckClient struct {
+	proto.KokaqDataPlaneClient
+	store     BlobStore
+	threshold int
+}
+
+// NewClaimCheckClient wraps c. A threshold of zero or less selects
+// DefaultClaimCheckThreshold.
+func NewClaimCheckClient(c proto.KokaqDataPlaneClient, store BlobStore, threshold int) *ClaimCheckClient {
+	if threshold <= 0 {
+		threshold = DefaultClaimCheckThreshold
+	}
+	return &ClaimCheckClient{KokaqDataPlaneClient: c, store: store, threshold: threshold}
+}
+
+// Enqueue uploads the payload first when it exceeds the threshold. The
+// request passed in is left untouched.
+func (c *ClaimCheckClient) Enqueue(ctx context.Context, in *proto.EnqueueRequest, opts ...grpc.CallOption) (*proto.EnqueueResponse, error) {
+	msg := in.GetMessage()
+	if len(msg.GetPayload()) <= c.threshold {
+		return c.KokaqDataPlaneClient.Enqueue(ctx, in, opts...)
+	}
+	sum := sha256.Sum256(msg.GetPayload())
+	key := hex.EncodeToString(sum[:])
+	uri, err := c.store.Put(ctx, key, msg.GetPayload())
+	if err != nil {
+		return nil, fmt.Errorf("client: upload payload of %q: %w", msg.GetMessageId(), err)
+	}
+	ref := &proto.PayloadReference{
+		Uri:    uri,
+		Size:   uint64(len(msg.GetPayload())),
+		Sha256: key,
+	}
+	if err := ref.Check(); err != nil {
+		return nil, fmt.Errorf("client: claim check of %q: %w", msg.GetMessageId(), err)
+	}
+	out := protobuf.Clone(in).(*proto.EnqueueRequest)
+	out.Message.Payload = nil
+	out.Message.PayloadRef = ref
+	return c.KokaqDataPlaneClient.Enqueue(ctx, out, opts...)
+}
+
+// Dequeue resolves claim-checked payloads of the returned messages.
+//
+// The server has removed the messages by the time it answers, so a failed
+// download does not fail the call: the message is returned with payload_ref
+// set and payload empty, and Resolve retries the download and reports why it
+// failed. Callers must check every message rather than rely on the error,
+// which only reports a failed Dequeue RPC.
+func (c *ClaimCheckClient) Dequeue(ctx context.Context, in *proto.DequeueRequest, opts ...grpc.CallOption) (*proto.DequeueResponse, error) {
+	resp, err := c.KokaqDataPlaneClient.Dequeue(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range resp.GetMessages() {
+		_ = c.Resolve(ctx, m.GetMessage())
+	}
+	return resp, nil
+}
+
+// Peek resolves claim-checked payloads of the returned messages.
+func (c *ClaimCheckClient) Peek(ctx context.Context, in *proto.PeekRequest, opts ...grpc.CallOption) (*proto.PeekResponse, error) {
+	resp, err := c.KokaqDataPlaneClient.Peek(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, c.resolveAll(ctx, resp.GetMessages())
+}
+
+// PeekLock resolves claim-checked payloads of the locked messages.
+func (c *ClaimCheckClient) PeekLock(ctx context.Context, in *proto.PeekLockRequest, opts ...grpc.CallOption) (*proto.PeekLockResponse, error) {
+	resp, err := c.KokaqDataPlaneClient.PeekLock(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range resp.GetLocked() {
+		if err := c.Resolve(ctx, l.GetMessage().GetMessage()); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// GetMessage resolves a claim-checked payload of the returned message.
+func (c *ClaimCheckClient) GetMessage(ctx context.Context, in *proto.GetMessageRequest, opts ...grpc.CallOption) (*proto.KokaqMessageResponse, error) {
+	resp, err := c.KokaqDataPlaneClient.GetMessage(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, c.Resolve(ctx, resp.GetMessage())
+}
+
+// ListMessages resolves claim-checked payloads when include_payload is set.
+func (c *ClaimCheckClient) ListMessages(ctx context.Context, in *proto.ListMessagesRequest, opts ...grpc.CallOption) (*proto.ListMessagesResponse, error) {
+	resp, err := c.KokaqDataPlaneClient.ListMessages(ctx, in, opts...)
+	if err != nil || !in.GetIncludePayload() {
+		return resp, err
+	}
+	return resp, c.resolveAll(ctx, resp.GetMessages())
+}
+
+func (c *ClaimCheckClient) resolveAll(ctx context.Context, msgs []*proto.KokaqMessageResponse) error {
+	for _, m := range msgs {
+		if err := c.Resolve(ctx, m.GetMessage()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resolve downloads the payload msg references into msg.payload and checks
+// it against the reference. Messages without a payload_ref, or whose payload
+// is already resolved, are left alone.
+func (c *ClaimCheckClient) Resolve(ctx context.Context, msg *proto.KokaqMessageRequest) error {
+	ref := msg.GetPayloadRef()
+	if ref == nil || len(msg.GetPayload()) > 0 {
+		return nil
+	}
+	data, err := c.store.Get(ctx, ref.GetUri())
+	if err != nil {
+		return fmt.Errorf("client: download payload of %q: %w", msg.GetMessageId(), err)
+	}
+	sum := sha256.Sum256(data)
+	if uint64(len(data)) != ref.GetSize() || (ref.GetSha256() != "" && hex.EncodeToString(sum[:]) != ref.GetSha256()) {
+		return fmt.Errorf("%w: message %q", ErrPayloadMismatch, msg.GetMessageId())
+	}
+	msg.Payload = data
+	return nil
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/grpc"
+)
+
+// mailbox hands every enqueued message back to the next Dequeue.
+type mailbox struct {
+	proto.KokaqDataPlaneClient
+	msgs []*proto.KokaqMessageRequest
+}
+
+func (m *mailbox) Enqueue(_ context.Context, in *proto.EnqueueRequest, _ ...grpc.CallOption) (*proto.EnqueueResponse, error) {
+	m.msgs = append(m.msgs, in.GetMessage())
+	return &proto.EnqueueResponse{MessageId: in.GetMessage().GetMessageId()}, nil
+}
+
+func (m *mailbox) Dequeue(context.Context, *proto.DequeueRequest, ...grpc.CallOption) (*proto.DequeueResponse, error) {
+	resp := &proto.DequeueResponse{}
+	for _, msg := range m.msgs {
+		resp.Messages = append(resp.Messages, &proto.KokaqMessageResponse{Message: msg})
+	}
+	m.msgs = nil
+	return resp, nil
+}
+
+func newStore(t *testing.T) *FileBlobStore {
+	t.Helper()
+	s, err := NewFileBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestClaimCheckRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	box := &mailbox{}
+	c := NewClaimCheckClient(box, newStore(t), 8)
+	small, large := []byte("8 bytes!"), []byte("nine bytes")
+	for id, payload := range map[string][]byte{"small": small, "large": large} {
+		in := &proto.EnqueueRequest{Message: &proto.KokaqMessageRequest{MessageId: id, Payload: payload}}
+		if _, err := c.Enqueue(ctx, in); err != nil {
+			t.Fatalf("Enqueue(%s): %v", id, err)
+		}
+		if !bytes.Equal(in.GetMessage().GetPayload(), payload) {
+			t.Errorf("Enqueue(%s) modified the request", id)
+		}
+	}
+	for _, m := range box.msgs {
+		switch m.GetMessageId() {
+		case "small":
+			if m.GetPayloadRef() != nil || !bytes.Equal(m.GetPayload(), small) {
+				t.Errorf("small payload sent as %v", m)
+			}
+		case "large":
+			if m.GetPayload() != nil || m.GetPayloadRef().GetSize() != uint64(len(large)) {
+				t.Errorf("large payload sent as %v", m)
+			}
+		}
+	}
+	resp, err := c.Dequeue(ctx, &proto.DequeueRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range resp.GetMessages() {
+		want := map[string][]byte{"small": small, "large": large}[m.GetMessage().GetMessageId()]
+		if !bytes.Equal(m.GetMessage().GetPayload(), want) {
+			t.Errorf("Dequeue(%s) payload = %q, want %q", m.GetMessage().GetMessageId(), m.GetMessage().GetPayload(), want)
+		}
+	}
+}
+
+func TestClaimCheckDequeueKeepsUnresolvedMessages(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t)
+	box := &mailbox{}
+	c := NewClaimCheckClient(box, store, 1)
+	if _, err := c.Enqueue(ctx, &proto.EnqueueRequest{Message: &proto.KokaqMessageRequest{MessageId: "m", Payload: []byte("payload")}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete(ctx, box.msgs[0].GetPayloadRef().GetUri()); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Dequeue(ctx, &proto.DequeueRequest{})
+	if err != nil || len(resp.GetMessages()) != 1 {
+		t.Fatalf("Dequeue = %v, %v, want the message despite the missing blob", resp, err)
+	}
+	m := resp.GetMessages()[0].GetMessage()
+	if m.GetPayload() != nil || m.GetPayloadRef() == nil {
+		t.Errorf("unresolved message = %v, want payload_ref only", m)
+	}
+	if err := c.Resolve(ctx, m); !errors.Is(err, ErrBlobNotFound) {
+		t.Errorf("Resolve = %v, want ErrBlobNotFound", err)
+	}
+}
+
+func TestClaimCheckPayloadMismatch(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t)
+	c := NewClaimCheckClient(&mailbox{}, store, 1)
+	uri, err := store.Put(ctx, "blob", []byte("tampered"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, ref := range map[string]*proto.PayloadReference{
+		"size":   {Uri: uri, Size: 3},
+		"sha256": {Uri: uri, Size: 8, Sha256: "00"},
+	} {
+		m := &proto.KokaqMessageRequest{MessageId: "m", PayloadRef: ref}
+		if err := c.Resolve(ctx, m); !errors.Is(err, ErrPayloadMismatch) {
+			t.Errorf("%s: Resolve = %v, want ErrPayloadMismatch", name, err)
+		}
+		if m.GetPayload() != nil {
+			t.Errorf("%s: mismatched payload stored in the message", name)
+		}
+	}
+}
+
+func TestFileBlobStoreRefusesOutsidePaths(t *testing.T) {
+	store := newStore(t)
+	outside := filepath.Join(filepath.Dir(store.dir), "outside")
+	if err := os.WriteFile(outside, []byte("secret"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, uri := range []string{
+		"file://" + filepath.ToSlash(outside),
+		"file://" + filepath.ToSlash(store.dir) + "/../outside",
+		"file://" + filepath.ToSlash(store.dir),
+		"file://" + filepath.ToSlash(store.dir) + "-sibling/blob",
+		"https://example.com/blob",
+		"%zz",
+	} {
+		if _, err := store.path(uri); err == nil {
+			t.Errorf("path(%q) accepted", uri)
+		}
+		if _, err := store.Get(context.Background(), uri); err == nil {
+			t.Errorf("Get(%q) succeeded", uri)
+		}
+	}
+	if _, err := store.Put(context.Background(), "../escape", nil); err == nil {
+		t.Error("Put with a key outside the directory succeeded")
+	}
+}
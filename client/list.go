@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// StatusError is returned when a response carries an unsuccessful
+// StatusResponse.
+type StatusError struct {
+	Code proto.ErrorCode
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("client: %s", e.Code)
+}
+
+// checkStatus treats a missing status as success, as servers may report
+// failures as gRPC errors instead.
+func checkStatus(s *proto.StatusResponse) error {
+	if s == nil || s.GetSuccess() {
+		return nil
+	}
+	return &StatusError{Code: s.GetError()}
+}
+
+// Namespaces walks every namespace matching req, fetching pages as the loop
+// advances. req.page_token selects where the walk starts; req is not
+// modified. The walk stops after yielding the first error.
+//
+//	for ns, err := range client.Namespaces(ctx, cp, &proto.ListNamespacesRequest{Prefix: "team-"}) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(ns.GetNamespace())
+//	}
+func Namespaces(ctx context.Context, c proto.KokaqControlPlaneClient, req *proto.ListNamespacesRequest, opts ...grpc.CallOption) iter.Seq2[*proto.KokaqNamespaceResponse, error] {
+	return func(yield func(*proto.KokaqNamespaceResponse, error) bool) {
+		req := protobuf.Clone(req).(*proto.ListNamespacesRequest)
+		for {
+			resp, err := c.ListNamespaces(ctx, req, opts...)
+			if err == nil {
+				err = checkStatus(resp.GetStatus())
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, ns := range resp.GetNamespaces() {
+				if !yield(ns, nil) {
+					return
+				}
+			}
+			if resp.GetNextPageToken() == "" {
+				return
+			}
+			req.PageToken = resp.GetNextPageToken()
+		}
+	}
+}
+
+// Queues walks every queue matching req like Namespaces walks namespaces.
+func Queues(ctx context.Context, c proto.KokaqControlPlaneClient, req *proto.ListQueuesRequest, opts ...grpc.CallOption) iter.Seq2[*proto.KokaqQueueResponse, error] {
+	return func(yield func(*proto.KokaqQueueResponse, error) bool) {
+		req := protobuf.Clone(req).(*proto.ListQueuesRequest)
+		for {
+			resp, err := c.ListQueues(ctx, req, opts...)
+			if err == nil {
+				err = checkStatus(resp.GetStatus())
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, q := range resp.GetQueues() {
+				if !yield(q, nil) {
+					return
+				}
+			}
+			if resp.GetNextPageToken() == "" {
+				return
+			}
+			req.PageToken = resp.GetNextPageToken()
+		}
+	}
+}
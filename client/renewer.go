@@ -0,0 +1,187 @@
+// Package client contains helpers for consumers of the kokaq data and
+// control planes.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+// MinRenewalInterval is the shortest time a LockRenewer waits between
+// renewals, however close the lock is to expiring.
+const MinRenewalInterval = 100 * time.Millisecond
+
+var (
+	// ErrLockLost is reported when the data plane refuses a renewal or
+	// confirms an expiry that is not in the future, which means another
+	// consumer may now receive the message.
+	ErrLockLost = errors.New("client: lock renewal not applied")
+	// ErrRenewalLimit is reported when renewal stopped because the maximum
+	// renewal duration passed before the handler finished.
+	ErrRenewalLimit = errors.New("client: lock renewal limit reached")
+)
+
+// LockRenewer keeps the lock on a LockedMessage alive while a handler runs.
+// It refreshes the lock halfway to lock_expires_at, less a random jitter so
+// many consumers do not renew in step, and at most once per
+// MinRenewalInterval.
+//
+//	r := client.NewLockRenewer(dp, locked, client.WithMaxRenewal(10*time.Minute))
+//	r.Start(ctx)
+//	err := handle(locked)
+//	if rerr := r.Stop(); rerr != nil {
+//		// the lock may have lapsed; do not Ack
+//	}
+type LockRenewer struct {
+	client     proto.KokaqDataPlaneClient
+	locked     *proto.LockedMessage
+	maxRenewal time.Duration
+	jitter     float64
+
+	mu     sync.Mutex
+	expiry time.Time
+	err    error
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// RenewerOption configures a LockRenewer.
+type RenewerOption func(*LockRenewer)
+
+// WithMaxRenewal bounds how long the lock is kept alive. Zero means until Stop.
+func WithMaxRenewal(d time.Duration) RenewerOption {
+	return func(r *LockRenewer) { r.maxRenewal = d }
+}
+
+// WithJitter sets the fraction of the remaining lock time, between 0 and 0.5,
+// that renewals are randomly brought forward by. The default is 0.1.
+func WithJitter(fraction float64) RenewerOption {
+	return func(r *LockRenewer) { r.jitter = min(max(fraction, 0), 0.5) }
+}
+
+// NewLockRenewer returns a renewer for locked. Call Start to begin renewing.
+func NewLockRenewer(client proto.KokaqDataPlaneClient, locked *proto.LockedMessage, opts ...RenewerOption) *LockRenewer {
+	r := &LockRenewer{
+		client: client,
+		locked: locked,
+		jitter: 0.1,
+		expiry: locked.GetLockExpiresAt().AsTime(),
+		done:   make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Start renews the lock in the background until Stop is called, ctx is
+// done, the maximum renewal duration passes or a renewal fails.
+func (r *LockRenewer) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+	go r.run(ctx)
+}
+
+// Stop ends renewal and waits for it to finish. It returns ErrLockLost,
+// ErrRenewalLimit or the transport error that ended renewal early, and nil
+// if the lock was still held when Stop was called.
+func (r *LockRenewer) Stop() error {
+	if r.cancel != nil {
+		r.cancel()
+		<-r.done
+	}
+	return r.Err()
+}
+
+// Done is closed once renewal has ended for any reason. Handlers can select
+// on it to abandon work whose lock has lapsed.
+func (r *LockRenewer) Done() <-chan struct{} {
+	return r.done
+}
+
+// Err returns the error that ended renewal, if any.
+func (r *LockRenewer) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// Expiry returns the lock expiry last confirmed by the data plane.
+func (r *LockRenewer) Expiry() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.expiry
+}
+
+func (r *LockRenewer) run(ctx context.Context) {
+	defer close(r.done)
+	var limit <-chan time.Time
+	if r.maxRenewal > 0 {
+		t := time.NewTimer(r.maxRenewal)
+		defer t.Stop()
+		limit = t.C
+	}
+	for {
+		t := time.NewTimer(r.nextRenewal())
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-limit:
+			t.Stop()
+			r.fail(ErrRenewalLimit)
+			return
+		case <-t.C:
+		}
+		if err := r.renew(ctx); err != nil {
+			if ctx.Err() == nil {
+				r.fail(err)
+			}
+			return
+		}
+	}
+}
+
+func (r *LockRenewer) nextRenewal() time.Duration {
+	remaining := time.Until(r.Expiry())
+	if remaining <= 0 {
+		return MinRenewalInterval
+	}
+	wait := remaining / 2
+	if r.jitter > 0 {
+		wait -= time.Duration(rand.Float64() * r.jitter * float64(remaining))
+	}
+	return max(wait, MinRenewalInterval)
+}
+
+func (r *LockRenewer) renew(ctx context.Context) error {
+	msg := r.locked.GetMessage().GetMessage()
+	resp, err := r.client.RefreshVisibilityTimeout(ctx, &proto.RefreshVisibilityTimeoutRequest{
+		Namespace: msg.GetNamespace(),
+		Queue:     msg.GetQueue(),
+		MessageId: msg.GetMessageId(),
+		LockId:    r.locked.GetLockId(),
+	})
+	if err != nil {
+		return fmt.Errorf("client: renew lock on %q: %w", msg.GetMessageId(), err)
+	}
+	expiry := resp.GetLockExpiresAt().AsTime()
+	if !resp.GetApplied() || resp.GetLockExpiresAt() == nil || !expiry.After(time.Now()) {
+		return ErrLockLost
+	}
+	r.mu.Lock()
+	r.expiry = expiry
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *LockRenewer) fail(err error) {
+	r.mu.Lock()
+	r.err = err
+	r.mu.Unlock()
+}
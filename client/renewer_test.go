@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// refresher answers RefreshVisibilityTimeout with the expiry next returns.
+type refresher struct {
+	proto.KokaqDataPlaneClient
+	calls atomic.Int32
+	next  func() *timestamppb.Timestamp
+}
+
+func (f *refresher) RefreshVisibilityTimeout(context.Context, *proto.RefreshVisibilityTimeoutRequest, ...grpc.CallOption) (*proto.VisibilityTimeoutResponse, error) {
+	f.calls.Add(1)
+	return &proto.VisibilityTimeoutResponse{Applied: true, LockExpiresAt: f.next()}, nil
+}
+
+func lockedUntil(t time.Time) *proto.LockedMessage {
+	return &proto.LockedMessage{
+		Message:       &proto.KokaqMessageResponse{Message: &proto.KokaqMessageRequest{MessageId: "m"}},
+		LockId:        "lock",
+		LockExpiresAt: timestamppb.New(t),
+	}
+}
+
+func waitDone(t *testing.T, r *LockRenewer) {
+	t.Helper()
+	select {
+	case <-r.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("renewer did not stop")
+	}
+}
+
+func TestRenewerStaleExpiryIsLockLost(t *testing.T) {
+	for name, next := range map[string]func() *timestamppb.Timestamp{
+		"past":    func() *timestamppb.Timestamp { return timestamppb.New(time.Now().Add(-time.Minute)) },
+		"missing": func() *timestamppb.Timestamp { return nil },
+	} {
+		t.Run(name, func(t *testing.T) {
+			dp := &refresher{next: next}
+			// An expiry already past, as under client/server clock skew.
+			r := NewLockRenewer(dp, lockedUntil(time.Now().Add(-time.Second)))
+			r.Start(context.Background())
+			waitDone(t, r)
+			if err := r.Err(); !errors.Is(err, ErrLockLost) {
+				t.Errorf("Err = %v, want ErrLockLost", err)
+			}
+			if n := dp.calls.Load(); n != 1 {
+				t.Errorf("%d renewals, want 1", n)
+			}
+		})
+	}
+}
+
+func TestRenewerMinInterval(t *testing.T) {
+	// Each renewal confirms a lock expiring almost at once.
+	dp := &refresher{next: func() *timestamppb.Timestamp { return timestamppb.New(time.Now().Add(time.Millisecond)) }}
+	r := NewLockRenewer(dp, lockedUntil(time.Now().Add(time.Millisecond)))
+	r.Start(context.Background())
+	time.Sleep(5 * MinRenewalInterval / 2)
+	r.Stop()
+	if n := dp.calls.Load(); n > 3 {
+		t.Errorf("%d renewals in %v, want at most 3", n, 5*MinRenewalInterval/2)
+	}
+}
+
+func TestRenewerKeepsLockAlive(t *testing.T) {
+	dp := &refresher{next: func() *timestamppb.Timestamp { return timestamppb.New(time.Now().Add(400 * time.Millisecond)) }}
+	r := NewLockRenewer(dp, lockedUntil(time.Now().Add(400*time.Millisecond)), WithJitter(0))
+	r.Start(context.Background())
+	time.Sleep(time.Second)
+	if err := r.Stop(); err != nil {
+		t.Fatalf("Stop = %v", err)
+	}
+	if n := dp.calls.Load(); n < 2 {
+		t.Errorf("%d renewals in 1s of a 400ms lock, want at least 2", n)
+	}
+	if !r.Expiry().After(time.Now()) {
+		t.Errorf("Expiry %v is not in the future", r.Expiry())
+	}
+}
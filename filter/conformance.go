@@ -0,0 +1,65 @@
+package filter
+
+import "github.com/kokaq/protocol/proto"
+
+// ConformanceCase is one entry of the shared filter conformance suite.
+// Implementations of the grammar in other languages should port these cases.
+type ConformanceCase struct {
+	Name    string
+	Expr    string
+	Message *proto.KokaqMessageRequest
+	Match   bool
+	Invalid bool // Expr must be rejected by Parse
+}
+
+func conformanceMessage() *proto.KokaqMessageRequest {
+	return &proto.KokaqMessageRequest{
+		MessageId: "m-1",
+		Priority:  7,
+		SessionId: "customer-42",
+		Headers: &proto.KokaqMessageHeaders{
+			ContentType:   "application/json",
+			CorrelationId: "c-9",
+			Source:        "billing",
+			Properties: map[string]string{
+				"tenant":  "acme",
+				"retries": "3",
+				"tier":    "gold",
+			},
+		},
+	}
+}
+
+// ConformanceCases lists the behaviour every filter implementation must agree on.
+var ConformanceCases = []ConformanceCase{
+	{Name: "empty matches all", Expr: "", Message: conformanceMessage(), Match: true},
+	{Name: "header equal", Expr: `content_type == "application/json"`, Message: conformanceMessage(), Match: true},
+	{Name: "header not equal", Expr: `source != "legacy"`, Message: conformanceMessage(), Match: true},
+	{Name: "and", Expr: `content_type == "application/json" AND source != "legacy"`, Message: conformanceMessage(), Match: true},
+	{Name: "and short", Expr: `content_type == "text/plain" AND source != "legacy"`, Message: conformanceMessage(), Match: false},
+	{Name: "or", Expr: `source == "legacy" OR correlation_id == 'c-9'`, Message: conformanceMessage(), Match: true},
+	{Name: "not", Expr: `NOT source == "billing"`, Message: conformanceMessage(), Match: false},
+	{Name: "keywords case-insensitive", Expr: `not source == "x" and priority > 1`, Message: conformanceMessage(), Match: true},
+	{Name: "and binds tighter than or", Expr: `source == "x" AND priority == 1 OR priority == 7`, Message: conformanceMessage(), Match: true},
+	{Name: "parentheses", Expr: `source == "x" AND (priority == 1 OR priority == 7)`, Message: conformanceMessage(), Match: false},
+	{Name: "priority numeric", Expr: `priority >= 7 AND priority < 10`, Message: conformanceMessage(), Match: true},
+	{Name: "message and session id", Expr: `message_id == "m-1" AND session_id == "customer-42"`, Message: conformanceMessage(), Match: true},
+	{Name: "property equal", Expr: `properties.tenant == "acme"`, Message: conformanceMessage(), Match: true},
+	{Name: "property numeric", Expr: `properties.retries > 2`, Message: conformanceMessage(), Match: true},
+	{Name: "property numeric not a number", Expr: `properties.tier > 2`, Message: conformanceMessage(), Match: false},
+	{Name: "property lexicographic", Expr: `properties.retries > "10"`, Message: conformanceMessage(), Match: true},
+	{Name: "missing property equal", Expr: `properties.region == "eu"`, Message: conformanceMessage(), Match: false},
+	{Name: "missing property not equal", Expr: `properties.region != "eu"`, Message: conformanceMessage(), Match: false},
+	{Name: "exists", Expr: `EXISTS properties.tenant AND NOT EXISTS properties.region`, Message: conformanceMessage(), Match: true},
+	{Name: "exists header", Expr: `EXISTS content_type`, Message: &proto.KokaqMessageRequest{}, Match: false},
+	{Name: "nil headers", Expr: `source != "legacy"`, Message: &proto.KokaqMessageRequest{}, Match: true},
+	{Name: "escaped quote", Expr: `properties.tenant != "ac\"me"`, Message: conformanceMessage(), Match: true},
+	{Name: "unknown field", Expr: `colour == "red"`, Invalid: true},
+	{Name: "single equals", Expr: `source = "billing"`, Invalid: true},
+	{Name: "priority needs number", Expr: `priority == "7"`, Invalid: true},
+	{Name: "unterminated string", Expr: `source == "billing`, Invalid: true},
+	{Name: "unbalanced parentheses", Expr: `(source == "billing"`, Invalid: true},
+	{Name: "trailing tokens", Expr: `source == "billing" source`, Invalid: true},
+	{Name: "dangling operator", Expr: `source == "billing" AND`, Invalid: true},
+	{Name: "empty property name", Expr: `properties. == "x"`, Invalid: true},
+}
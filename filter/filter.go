@@ -0,0 +1,192 @@
+// Package filter parses and evaluates the message filter expressions carried
+// by DequeueRequest.filter and PeekRequest.filter, so servers and clients
+// share one grammar.
+//
+// An expression compares message fields against string or number literals
+// and combines the comparisons with AND, OR, NOT and parentheses:
+//
+//	content_type == "application/json" AND source != "legacy"
+//	priority >= 10 OR (EXISTS properties.tenant AND NOT properties.tier == 'free')
+//
+// The fields are message_id, session_id, priority and the headers
+// content_type, correlation_id and source. Application properties are named
+// with the "properties." prefix. Supported operators are ==, !=, <, <=, > and
+// >=; a number literal compares numerically, a string literal
+// lexicographically. Any comparison against a property the message does not
+// carry is false, whatever the operator. Keywords are case-insensitive.
+package filter
+
+import (
+	"strconv"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+// PropertyPrefix introduces an application property name in an expression.
+const PropertyPrefix = "properties."
+
+// Filter is a parsed filter expression. The zero value and a Filter parsed
+// from an empty expression match every message.
+type Filter struct {
+	src  string
+	root node
+}
+
+// Parse parses expr. An empty expression yields a Filter matching everything.
+func Parse(expr string) (*Filter, error) {
+	toks, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+	f := &Filter{src: expr}
+	if toks[0].kind == tokEOF {
+		return f, nil
+	}
+	p := &parser{toks: toks}
+	if f.root, err = p.parseOr(); err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, p.errorf(t, "unexpected %s", t)
+	}
+	return f, nil
+}
+
+// MustParse is like Parse but panics on a malformed expression.
+func MustParse(expr string) *Filter {
+	f, err := Parse(expr)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Match reports whether msg satisfies the filter.
+func (f *Filter) Match(msg *proto.KokaqMessageRequest) bool {
+	if f == nil || f.root == nil {
+		return true
+	}
+	return f.root.eval(msg)
+}
+
+// String returns the expression the filter was parsed from.
+func (f *Filter) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.src
+}
+
+type field struct {
+	name     string
+	property string
+	numeric  bool
+	get      func(*proto.KokaqMessageRequest) string
+}
+
+var fields = map[string]field{
+	"message_id": {name: "message_id", get: (*proto.KokaqMessageRequest).GetMessageId},
+	"session_id": {name: "session_id", get: (*proto.KokaqMessageRequest).GetSessionId},
+	"priority": {name: "priority", numeric: true, get: func(m *proto.KokaqMessageRequest) string {
+		return strconv.FormatUint(m.GetPriority(), 10)
+	}},
+	"content_type": {name: "content_type", get: func(m *proto.KokaqMessageRequest) string {
+		return m.GetHeaders().GetContentType()
+	}},
+	"correlation_id": {name: "correlation_id", get: func(m *proto.KokaqMessageRequest) string {
+		return m.GetHeaders().GetCorrelationId()
+	}},
+	"source": {name: "source", get: func(m *proto.KokaqMessageRequest) string {
+		return m.GetHeaders().GetSource()
+	}},
+}
+
+func (f field) value(msg *proto.KokaqMessageRequest) (string, bool) {
+	if f.property != "" {
+		return msg.Property(f.property)
+	}
+	return f.get(msg), true
+}
+
+type literal struct {
+	text     string
+	number   float64
+	isNumber bool
+}
+
+type node interface {
+	eval(msg *proto.KokaqMessageRequest) bool
+}
+
+type andNode struct{ left, right node }
+
+func (n andNode) eval(msg *proto.KokaqMessageRequest) bool {
+	return n.left.eval(msg) && n.right.eval(msg)
+}
+
+type orNode struct{ left, right node }
+
+func (n orNode) eval(msg *proto.KokaqMessageRequest) bool {
+	return n.left.eval(msg) || n.right.eval(msg)
+}
+
+type notNode struct{ inner node }
+
+func (n notNode) eval(msg *proto.KokaqMessageRequest) bool {
+	return !n.inner.eval(msg)
+}
+
+type existsNode struct{ field field }
+
+func (n existsNode) eval(msg *proto.KokaqMessageRequest) bool {
+	v, ok := n.field.value(msg)
+	return ok && (n.field.property != "" || n.field.numeric || v != "")
+}
+
+type compareNode struct {
+	field field
+	op    string
+	lit   literal
+}
+
+func (n compareNode) eval(msg *proto.KokaqMessageRequest) bool {
+	v, ok := n.field.value(msg)
+	if !ok {
+		return false
+	}
+	var c int
+	if n.lit.isNumber {
+		x, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return false
+		}
+		switch {
+		case x < n.lit.number:
+			c = -1
+		case x > n.lit.number:
+			c = 1
+		}
+	} else {
+		switch {
+		case v < n.lit.text:
+			c = -1
+		case v > n.lit.text:
+			c = 1
+		}
+	}
+	switch n.op {
+	case "==":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
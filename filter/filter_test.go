@@ -0,0 +1,37 @@
+package filter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	for _, c := range ConformanceCases {
+		t.Run(c.Name, func(t *testing.T) {
+			f, err := Parse(c.Expr)
+			if c.Invalid {
+				var syntax *SyntaxError
+				if !errors.As(err, &syntax) {
+					t.Fatalf("Parse(%q) = %v, want a *SyntaxError", c.Expr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", c.Expr, err)
+			}
+			if got := f.Match(c.Message); got != c.Match {
+				t.Errorf("Parse(%q).Match = %v, want %v", c.Expr, got, c.Match)
+			}
+			if got := f.String(); got != c.Expr {
+				t.Errorf("String() = %q, want %q", got, c.Expr)
+			}
+		})
+	}
+}
+
+func TestNilFilterMatchesAll(t *testing.T) {
+	var f *Filter
+	if !f.Match(conformanceMessage()) {
+		t.Error("nil Filter does not match")
+	}
+}
@@ -0,0 +1,149 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SyntaxError reports a malformed filter expression.
+type SyntaxError struct {
+	Pos int // byte offset into the expression
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("filter: %s at offset %d", e.Msg, e.Pos)
+}
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	return &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// expr := and { OR and }
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left, right}
+	}
+	return left, nil
+}
+
+// and := unary { AND unary }
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left, right}
+	}
+	return left, nil
+}
+
+// unary := NOT unary | EXISTS field | "(" expr ")" | field op literal
+func (p *parser) parseUnary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNot:
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{inner}, nil
+	case tokExists:
+		f, err := p.parseField(p.next())
+		if err != nil {
+			return nil, err
+		}
+		return existsNode{f}, nil
+	case tokLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if c := p.next(); c.kind != tokRParen {
+			return nil, p.errorf(c, "expected ')', found %s", c)
+		}
+		return inner, nil
+	case tokIdent:
+		f, err := p.parseField(t)
+		if err != nil {
+			return nil, err
+		}
+		op := p.next()
+		if op.kind != tokOp {
+			return nil, p.errorf(op, "expected comparison operator, found %s", op)
+		}
+		lit, err := p.parseLiteral(p.next())
+		if err != nil {
+			return nil, err
+		}
+		if f.numeric && !lit.isNumber {
+			return nil, p.errorf(op, "%s compares against a number", f.name)
+		}
+		return compareNode{field: f, op: op.text, lit: lit}, nil
+	default:
+		return nil, p.errorf(t, "expected comparison, found %s", t)
+	}
+}
+
+func (p *parser) parseField(t token) (field, error) {
+	if t.kind != tokIdent {
+		return field{}, p.errorf(t, "expected field name, found %s", t)
+	}
+	if key, ok := strings.CutPrefix(t.text, PropertyPrefix); ok {
+		if key == "" {
+			return field{}, p.errorf(t, "missing property name")
+		}
+		return field{name: t.text, property: key}, nil
+	}
+	f, ok := fields[t.text]
+	if !ok {
+		return field{}, p.errorf(t, "unknown field %q", t.text)
+	}
+	return f, nil
+}
+
+func (p *parser) parseLiteral(t token) (literal, error) {
+	switch t.kind {
+	case tokString:
+		return literal{text: t.text}, nil
+	case tokNumber:
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return literal{}, p.errorf(t, "invalid number %q", t.text)
+		}
+		return literal{text: t.text, number: n, isNumber: true}, nil
+	default:
+		return literal{}, p.errorf(t, "expected string or number, found %s", t)
+	}
+}
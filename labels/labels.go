@@ -0,0 +1,65 @@
+// Package labels validates the labels carried by namespaces and queues and
+// parses the label selectors of ListNamespacesRequest.label_selector and
+// ListQueuesRequest.label_selector, so servers and clients share one grammar.
+//
+// A selector is a comma-separated list of requirements, all of which must
+// hold:
+//
+//	team=payments, env!=dev, cost-center, !legacy
+//	tier in (gold, silver), region notin (eu-west)
+//
+// key=value and key==value require the label with that value; key!=value
+// requires the label to be missing or to hold another value. A bare key
+// requires the label, !key requires its absence. in and notin compare against
+// a set of values, with notin also holding for a missing label. An empty
+// selector matches everything.
+//
+// Keys are 1 to 63 characters of lower-case letters, digits, '-', '_', '.'
+// and '/', starting and ending with a letter or digit. Values are at most 63
+// characters of letters, digits, '-', '_' and '.', starting and ending with a
+// letter or digit, and may be empty.
+package labels
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// MaxLabels caps the number of labels on one namespace or queue.
+const MaxLabels = 64
+
+var (
+	keyPattern   = regexp.MustCompile(`^[a-z0-9]([a-z0-9._/-]{0,61}[a-z0-9])?$`)
+	valuePattern = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]{0,61}[A-Za-z0-9])?)?$`)
+)
+
+// Validate reports the first problem with labels. Servers reject requests
+// carrying invalid labels with ERROR_INVALID_ARGUMENT.
+func Validate(labels map[string]string) error {
+	if len(labels) > MaxLabels {
+		return fmt.Errorf("labels: %d labels, at most %d allowed", len(labels), MaxLabels)
+	}
+	for k, v := range labels {
+		if err := checkKey(k); err != nil {
+			return err
+		}
+		if err := checkValue(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkKey(k string) error {
+	if !keyPattern.MatchString(k) {
+		return fmt.Errorf("labels: invalid key %q", k)
+	}
+	return nil
+}
+
+func checkValue(v string) error {
+	if !valuePattern.MatchString(v) {
+		return fmt.Errorf("labels: invalid value %q", v)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package labels
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tooMany := make(map[string]string)
+	for i := range MaxLabels + 1 {
+		tooMany[fmt.Sprintf("k%d", i)] = ""
+	}
+	for _, c := range []struct {
+		labels map[string]string
+		ok     bool
+	}{
+		{nil, true},
+		{map[string]string{"team": "payments", "cost-center": "", "a.b/c_d": "X-1.y_z"}, true},
+		{map[string]string{strings.Repeat("k", 63): strings.Repeat("v", 63)}, true},
+		{map[string]string{strings.Repeat("k", 64): ""}, false},
+		{map[string]string{"k": strings.Repeat("v", 64)}, false},
+		{map[string]string{"Team": "x"}, false},
+		{map[string]string{"team-": "x"}, false},
+		{map[string]string{"team": "x y"}, false},
+		{map[string]string{"team": "-x"}, false},
+		{tooMany, false},
+	} {
+		if err := Validate(c.labels); (err == nil) != c.ok {
+			t.Errorf("Validate(%v) = %v, want ok=%v", c.labels, err, c.ok)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package labels
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SyntaxError reports a malformed selector.
+type SyntaxError struct {
+	Pos int // byte offset into the selector
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("labels: %s at offset %d", e.Msg, e.Pos)
+}
+
+type operator int
+
+const (
+	opExists operator = iota
+	opNotExists
+	opEquals
+	opNotEquals
+	opIn
+	opNotIn
+)
+
+type requirement struct {
+	key    string
+	op     operator
+	values []string
+}
+
+func (r requirement) match(labels map[string]string) bool {
+	v, ok := labels[r.key]
+	switch r.op {
+	case opExists:
+		return ok
+	case opNotExists:
+		return !ok
+	case opEquals, opIn:
+		return ok && slices.Contains(r.values, v)
+	}
+	return !ok || !slices.Contains(r.values, v)
+}
+
+// Selector is a parsed label selector. The zero value and a Selector parsed
+// from an empty string match every label set.
+type Selector struct {
+	src  string
+	reqs []requirement
+}
+
+// Parse parses selector.
+func Parse(selector string) (*Selector, error) {
+	sel := &Selector{src: selector}
+	p := &parser{src: selector}
+	if p.skipSpace(); p.done() {
+		return sel, nil
+	}
+	for {
+		r, err := p.requirement()
+		if err != nil {
+			return nil, err
+		}
+		sel.reqs = append(sel.reqs, r)
+		if p.skipSpace(); p.done() {
+			return sel, nil
+		}
+		if !p.consume(",") {
+			return nil, p.errorf("expected ','")
+		}
+	}
+}
+
+// MustParse is like Parse but panics on a malformed selector.
+func MustParse(selector string) *Selector {
+	s, err := Parse(selector)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Match reports whether labels satisfy every requirement of the selector.
+func (s *Selector) Match(labels map[string]string) bool {
+	if s == nil {
+		return true
+	}
+	for _, r := range s.reqs {
+		if !r.match(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the selector text s was parsed from.
+func (s *Selector) String() string {
+	if s == nil {
+		return ""
+	}
+	return s.src
+}
+
+type parser struct {
+	src string
+	pos int
+}
+
+func (p *parser) done() bool { return p.pos == len(p.src) }
+
+func (p *parser) skipSpace() {
+	for !p.done() && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *parser) consume(s string) bool {
+	if strings.HasPrefix(p.src[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return &SyntaxError{Pos: p.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// word scans a key, value or keyword.
+func (p *parser) word() string {
+	start := p.pos
+	for !p.done() && !strings.ContainsRune(" \t,=!()", rune(p.src[p.pos])) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+// requirement := "!" key | key [ ("=" | "==" | "!=") value | ("in" | "notin") set ]
+func (p *parser) requirement() (requirement, error) {
+	p.skipSpace()
+	if p.consume("!") {
+		p.skipSpace()
+		key, err := p.key()
+		return requirement{key: key, op: opNotExists}, err
+	}
+	key, err := p.key()
+	if err != nil {
+		return requirement{}, err
+	}
+	p.skipSpace()
+	r := requirement{key: key}
+	switch {
+	case p.consume("!="):
+		r.op = opNotEquals
+	case p.consume("=="), p.consume("="):
+		r.op = opEquals
+	default:
+		start := p.pos
+		switch p.word() {
+		case "in":
+			r.op = opIn
+		case "notin":
+			r.op = opNotIn
+		default:
+			p.pos = start
+			return requirement{key: key, op: opExists}, nil
+		}
+		r.values, err = p.set()
+		return r, err
+	}
+	p.skipSpace()
+	v, err := p.value()
+	r.values = []string{v}
+	return r, err
+}
+
+// set := "(" value { "," value } ")"
+func (p *parser) set() ([]string, error) {
+	if p.skipSpace(); !p.consume("(") {
+		return nil, p.errorf("expected '('")
+	}
+	if p.skipSpace(); p.consume(")") {
+		return nil, p.errorf("empty set")
+	}
+	var values []string
+	for {
+		p.skipSpace()
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+		p.skipSpace()
+		if p.consume(")") {
+			return values, nil
+		}
+		if !p.consume(",") {
+			return nil, p.errorf("expected ',' or ')'")
+		}
+	}
+}
+
+func (p *parser) key() (string, error) {
+	start := p.pos
+	k := p.word()
+	if k == "" {
+		return "", p.errorf("expected label key")
+	}
+	if err := checkKey(k); err != nil {
+		return "", &SyntaxError{Pos: start, Msg: fmt.Sprintf("invalid key %q", k)}
+	}
+	return k, nil
+}
+
+func (p *parser) value() (string, error) {
+	start := p.pos
+	v := p.word()
+	if err := checkValue(v); err != nil {
+		return "", &SyntaxError{Pos: start, Msg: fmt.Sprintf("invalid value %q", v)}
+	}
+	return v, nil
+}
@@ -0,0 +1,95 @@
+package labels
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	set := map[string]string{"team": "payments", "env": "prod", "tier": "gold", "empty": ""}
+	for _, c := range []struct {
+		selector string
+		want     bool
+	}{
+		{"", true},
+		{"  ", true},
+		{"team=payments", true},
+		{"team==payments", true},
+		{"team = payments", true},
+		{"team=search", false},
+		{"missing=x", false},
+		{"team!=search", true},
+		{"team!=payments", false},
+		{"missing!=x", true},
+		{"team", true},
+		{"missing", false},
+		{"!missing", true},
+		{"!team", false},
+		{"! team", false},
+		{"tier in (gold, silver)", true},
+		{"tier in (bronze)", false},
+		{"missing in (gold)", false},
+		{"tier notin (bronze, silver)", true},
+		{"tier notin (gold)", false},
+		{"missing notin (gold)", true},
+		{"empty=", true},
+		{"empty in (x, )", true},
+		{"team=payments, env!=dev, tier in (gold), !legacy", true},
+		{"team=payments, env=dev", false},
+		// A key named like a keyword is still a key.
+		{"in", false},
+	} {
+		sel, err := Parse(c.selector)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", c.selector, err)
+			continue
+		}
+		if got := sel.Match(set); got != c.want {
+			t.Errorf("Parse(%q).Match = %v, want %v", c.selector, got, c.want)
+		}
+		if sel.String() != c.selector {
+			t.Errorf("Parse(%q).String() = %q", c.selector, sel.String())
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, c := range []struct {
+		selector string
+		pos      int
+	}{
+		{"=x", 0},
+		{"!", 1},
+		{"Team=x", 0},
+		{"-team=x", 0},
+		{strings.Repeat("k", 64) + "=x", 0},
+		{"team=-x", 5},
+		{"team=" + strings.Repeat("v", 64), 5},
+		{"team=x y", 7},
+		{"team=x,", 7},
+		{"team in gold", 8},
+		{"team in ()", 10},
+		{"team in ( )", 11},
+		{"team in (gold", 13},
+		{"team in (gold silver)", 14},
+		{"team in (-gold)", 9},
+	} {
+		_, err := Parse(c.selector)
+		var se *SyntaxError
+		if !errors.As(err, &se) {
+			t.Errorf("Parse(%q) = %v, want a SyntaxError", c.selector, err)
+			continue
+		}
+		if se.Pos != c.pos {
+			t.Errorf("Parse(%q) error at offset %d, want %d: %v", c.selector, se.Pos, c.pos, err)
+		}
+	}
+}
+
+func TestNilSelectorMatchesEverything(t *testing.T) {
+	var sel *Selector
+	if !sel.Match(map[string]string{"a": "b"}) || sel.String() != "" {
+		t.Error("nil Selector does not behave like an empty one")
+	}
+}
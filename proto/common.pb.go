@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -25,29 +26,35 @@ const (
 type ErrorCode int32
 
 const (
-	ErrorCode_ERROR_NONE               ErrorCode = 0 // No error
-	ErrorCode_ERROR_NOT_FOUND          ErrorCode = 1 // Generic not found (queue, namespace, etc.)
-	ErrorCode_ERROR_UNAUTHORIZED       ErrorCode = 2 // Access denied
-	ErrorCode_ERROR_INTERNAL           ErrorCode = 3 // Internal server error
-	ErrorCode_ERROR_QUEUE_DISABLED     ErrorCode = 4 // Queue exists but is disabled
-	ErrorCode_ERROR_SHARD_UNHEALTHY    ErrorCode = 5 // Target shard unavailable or unhealthy
-	ErrorCode_ERROR_TIMEOUT            ErrorCode = 6 // Request timed out
-	ErrorCode_ERROR_INVALID_ARGUMENT   ErrorCode = 7 // Malformed or missing request data
-	ErrorCode_ERROR_DEPENDENCY_FAILURE ErrorCode = 8 // Downstream system (e.g., storage, network) failed
+	ErrorCode_ERROR_NONE               ErrorCode = 0  // No error
+	ErrorCode_ERROR_NOT_FOUND          ErrorCode = 1  // Generic not found (queue, namespace, etc.)
+	ErrorCode_ERROR_UNAUTHORIZED       ErrorCode = 2  // Access denied
+	ErrorCode_ERROR_INTERNAL           ErrorCode = 3  // Internal server error
+	ErrorCode_ERROR_QUEUE_DISABLED     ErrorCode = 4  // Queue exists but is disabled
+	ErrorCode_ERROR_SHARD_UNHEALTHY    ErrorCode = 5  // Target shard unavailable or unhealthy
+	ErrorCode_ERROR_TIMEOUT            ErrorCode = 6  // Request timed out
+	ErrorCode_ERROR_INVALID_ARGUMENT   ErrorCode = 7  // Malformed or missing request data
+	ErrorCode_ERROR_DEPENDENCY_FAILURE ErrorCode = 8  // Downstream system (e.g., storage, network) failed
+	ErrorCode_ERROR_TXN_ABORTED        ErrorCode = 9  // Transaction timed out, was aborted or failed to commit
+	ErrorCode_ERROR_CONFLICT           ErrorCode = 10 // Concurrent modification, the supplied etag is stale
+	ErrorCode_ERROR_QUOTA_EXCEEDED     ErrorCode = 11 // A namespace or queue quota would be exceeded
 )
 
 // Enum value maps for ErrorCode.
 var (
 	ErrorCode_name = map[int32]string{
-		0: "ERROR_NONE",
-		1: "ERROR_NOT_FOUND",
-		2: "ERROR_UNAUTHORIZED",
-		3: "ERROR_INTERNAL",
-		4: "ERROR_QUEUE_DISABLED",
-		5: "ERROR_SHARD_UNHEALTHY",
-		6: "ERROR_TIMEOUT",
-		7: "ERROR_INVALID_ARGUMENT",
-		8: "ERROR_DEPENDENCY_FAILURE",
+		0:  "ERROR_NONE",
+		1:  "ERROR_NOT_FOUND",
+		2:  "ERROR_UNAUTHORIZED",
+		3:  "ERROR_INTERNAL",
+		4:  "ERROR_QUEUE_DISABLED",
+		5:  "ERROR_SHARD_UNHEALTHY",
+		6:  "ERROR_TIMEOUT",
+		7:  "ERROR_INVALID_ARGUMENT",
+		8:  "ERROR_DEPENDENCY_FAILURE",
+		9:  "ERROR_TXN_ABORTED",
+		10: "ERROR_CONFLICT",
+		11: "ERROR_QUOTA_EXCEEDED",
 	}
 	ErrorCode_value = map[string]int32{
 		"ERROR_NONE":               0,
@@ -59,6 +66,9 @@ var (
 		"ERROR_TIMEOUT":            6,
 		"ERROR_INVALID_ARGUMENT":   7,
 		"ERROR_DEPENDENCY_FAILURE": 8,
+		"ERROR_TXN_ABORTED":        9,
+		"ERROR_CONFLICT":           10,
+		"ERROR_QUOTA_EXCEEDED":     11,
 	}
 )
 
@@ -103,6 +113,7 @@ const (
 	FailureReason_QUEUE_NOT_FOUND             FailureReason = 8  // Queue missing at processing time
 	FailureReason_DROPPED_DUE_TO_SHUTDOWN     FailureReason = 9  // Message lost during node shutdown
 	FailureReason_VISIBILITY_TIMEOUT_EXCEEDED FailureReason = 10 // Lock expired before ack or renew
+	FailureReason_FORWARDING_LOOP             FailureReason = 11 // Auto-forwarding exceeded the hop limit
 )
 
 // Enum value maps for FailureReason.
@@ -119,6 +130,7 @@ var (
 		8:  "QUEUE_NOT_FOUND",
 		9:  "DROPPED_DUE_TO_SHUTDOWN",
 		10: "VISIBILITY_TIMEOUT_EXCEEDED",
+		11: "FORWARDING_LOOP",
 	}
 	FailureReason_value = map[string]int32{
 		"MESSAGE_FAILURE_UNSPECIFIED": 0,
@@ -132,6 +144,7 @@ var (
 		"QUEUE_NOT_FOUND":             8,
 		"DROPPED_DUE_TO_SHUTDOWN":     9,
 		"VISIBILITY_TIMEOUT_EXCEEDED": 10,
+		"FORWARDING_LOOP":             11,
 	}
 )
 
@@ -162,6 +175,110 @@ func (FailureReason) EnumDescriptor() ([]byte, []int) {
 	return file_proto_common_proto_rawDescGZIP(), []int{1}
 }
 
+type BackoffKind int32
+
+const (
+	BackoffKind_BACKOFF_NONE        BackoffKind = 0 // Redeliver as soon as the message is released
+	BackoffKind_BACKOFF_LINEAR      BackoffKind = 1 // initial_delay * retry_count
+	BackoffKind_BACKOFF_EXPONENTIAL BackoffKind = 2 // initial_delay * multiplier^(retry_count-1)
+)
+
+// Enum value maps for BackoffKind.
+var (
+	BackoffKind_name = map[int32]string{
+		0: "BACKOFF_NONE",
+		1: "BACKOFF_LINEAR",
+		2: "BACKOFF_EXPONENTIAL",
+	}
+	BackoffKind_value = map[string]int32{
+		"BACKOFF_NONE":        0,
+		"BACKOFF_LINEAR":      1,
+		"BACKOFF_EXPONENTIAL": 2,
+	}
+)
+
+func (x BackoffKind) Enum() *BackoffKind {
+	p := new(BackoffKind)
+	*p = x
+	return p
+}
+
+func (x BackoffKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackoffKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_common_proto_enumTypes[2].Descriptor()
+}
+
+func (BackoffKind) Type() protoreflect.EnumType {
+	return &file_proto_common_proto_enumTypes[2]
+}
+
+func (x BackoffKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackoffKind.Descriptor instead.
+func (BackoffKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{2}
+}
+
+// Which data plane operations a queue serves. Refused operations fail with
+// ERROR_QUEUE_DISABLED; management calls such as GetQueue, GetStats,
+// ClearQueue and DeleteMessage are served in every status.
+type QueueStatus int32
+
+const (
+	QueueStatus_QUEUE_STATUS_ACTIVE           QueueStatus = 0 // Everything is served
+	QueueStatus_QUEUE_STATUS_SEND_DISABLED    QueueStatus = 1 // Enqueue, topic fan-out and forwarding into the queue are refused
+	QueueStatus_QUEUE_STATUS_RECEIVE_DISABLED QueueStatus = 2 // Dequeue, PeekLock and AcceptSession are refused; held locks can still be settled
+	QueueStatus_QUEUE_STATUS_DISABLED         QueueStatus = 3 // Every data plane operation is refused
+)
+
+// Enum value maps for QueueStatus.
+var (
+	QueueStatus_name = map[int32]string{
+		0: "QUEUE_STATUS_ACTIVE",
+		1: "QUEUE_STATUS_SEND_DISABLED",
+		2: "QUEUE_STATUS_RECEIVE_DISABLED",
+		3: "QUEUE_STATUS_DISABLED",
+	}
+	QueueStatus_value = map[string]int32{
+		"QUEUE_STATUS_ACTIVE":           0,
+		"QUEUE_STATUS_SEND_DISABLED":    1,
+		"QUEUE_STATUS_RECEIVE_DISABLED": 2,
+		"QUEUE_STATUS_DISABLED":         3,
+	}
+)
+
+func (x QueueStatus) Enum() *QueueStatus {
+	p := new(QueueStatus)
+	*p = x
+	return p
+}
+
+func (x QueueStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_common_proto_enumTypes[3].Descriptor()
+}
+
+func (QueueStatus) Type() protoreflect.EnumType {
+	return &file_proto_common_proto_enumTypes[3]
+}
+
+func (x QueueStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueStatus.Descriptor instead.
+func (QueueStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{3}
+}
+
 // Generic status response for any RPC call
 type StatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -215,17 +332,435 @@ func (x *StatusResponse) GetError() ErrorCode {
 	return ErrorCode_ERROR_NONE
 }
 
-type KokaqStatsResponse struct {
+// Delay applied before redelivering a message after a Nack or an expired lock
+type RetryPolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stats         map[string]uint64      `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	Status        *StatusResponse        `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Backoff       BackoffKind            `protobuf:"varint,1,opt,name=backoff,proto3,enum=proto.BackoffKind" json:"backoff,omitempty"`
+	InitialDelay  *durationpb.Duration   `protobuf:"bytes,2,opt,name=initial_delay,json=initialDelay,proto3" json:"initial_delay,omitempty"`
+	MaxDelay      *durationpb.Duration   `protobuf:"bytes,3,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"` // caps the computed delay, unset means no cap
+	Jitter        float64                `protobuf:"fixed64,4,opt,name=jitter,proto3" json:"jitter,omitempty"`                   // fraction of the delay randomly added or removed, 0 to 1
+	Multiplier    float64                `protobuf:"fixed64,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`           // exponential growth factor, 0 means 2
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_proto_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RetryPolicy) GetBackoff() BackoffKind {
+	if x != nil {
+		return x.Backoff
+	}
+	return BackoffKind_BACKOFF_NONE
+}
+
+func (x *RetryPolicy) GetInitialDelay() *durationpb.Duration {
+	if x != nil {
+		return x.InitialDelay
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetMaxDelay() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+// Limits on a namespace or a queue; zero leaves a limit unset. Messages are
+// measured by their encoded KokaqMessageRequest size and counted until they
+// are removed, dead letters included. An Enqueue that would break a limit of
+// its queue or namespace fails with ERROR_QUOTA_EXCEEDED.
+type Quota struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MaxQueues      uint64                 `protobuf:"varint,1,opt,name=max_queues,json=maxQueues,proto3" json:"max_queues,omitempty"` // namespaces only, enforced by AddQueue
+	MaxMessages    uint64                 `protobuf:"varint,2,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"`
+	MaxTotalBytes  uint64                 `protobuf:"varint,3,opt,name=max_total_bytes,json=maxTotalBytes,proto3" json:"max_total_bytes,omitempty"`
+	MaxMessageSize uint64                 `protobuf:"varint,4,opt,name=max_message_size,json=maxMessageSize,proto3" json:"max_message_size,omitempty"`
+	MaxEnqueueRate uint32                 `protobuf:"varint,5,opt,name=max_enqueue_rate,json=maxEnqueueRate,proto3" json:"max_enqueue_rate,omitempty"` // messages per second, with bursts of up to one second's worth
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_proto_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Quota) GetMaxQueues() uint64 {
+	if x != nil {
+		return x.MaxQueues
+	}
+	return 0
+}
+
+func (x *Quota) GetMaxMessages() uint64 {
+	if x != nil {
+		return x.MaxMessages
+	}
+	return 0
+}
+
+func (x *Quota) GetMaxTotalBytes() uint64 {
+	if x != nil {
+		return x.MaxTotalBytes
+	}
+	return 0
+}
+
+func (x *Quota) GetMaxMessageSize() uint64 {
+	if x != nil {
+		return x.MaxMessageSize
+	}
+	return 0
+}
+
+func (x *Quota) GetMaxEnqueueRate() uint32 {
+	if x != nil {
+		return x.MaxEnqueueRate
+	}
+	return 0
+}
+
+// Counts at the time of the snapshot unless noted. Messages are in the same
+// states as KokaqMessageResponse.state reports.
+type QueueStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Namespace         string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue             string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	ActiveCount       uint64                 `protobuf:"varint,3,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"` // visible, ready for delivery
+	LockedCount       uint64                 `protobuf:"varint,4,opt,name=locked_count,json=lockedCount,proto3" json:"locked_count,omitempty"`
+	ScheduledCount    uint64                 `protobuf:"varint,5,opt,name=scheduled_count,json=scheduledCount,proto3" json:"scheduled_count,omitempty"` // waiting out a redelivery delay
+	DeadLetteredCount uint64                 `protobuf:"varint,6,opt,name=dead_lettered_count,json=deadLetteredCount,proto3" json:"dead_lettered_count,omitempty"`
+	TotalBytes        uint64                 `protobuf:"varint,7,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`                     // as counted against Quota.max_total_bytes
+	EnqueueRate       float64                `protobuf:"fixed64,8,opt,name=enqueue_rate,json=enqueueRate,proto3" json:"enqueue_rate,omitempty"`                 // messages per second over the last minute
+	DequeueRate       float64                `protobuf:"fixed64,9,opt,name=dequeue_rate,json=dequeueRate,proto3" json:"dequeue_rate,omitempty"`                 // messages per second handed out by Dequeue and PeekLock over the last minute
+	OldestMessageAge  *durationpb.Duration   `protobuf:"bytes,10,opt,name=oldest_message_age,json=oldestMessageAge,proto3" json:"oldest_message_age,omitempty"` // of the oldest message not dead-lettered
+	LockExpirations   uint64                 `protobuf:"varint,11,opt,name=lock_expirations,json=lockExpirations,proto3" json:"lock_expirations,omitempty"`     // locks that lapsed unsettled since the queue was created
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QueueStats) Reset() {
+	*x = QueueStats{}
+	mi := &file_proto_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStats) ProtoMessage() {}
+
+func (x *QueueStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStats.ProtoReflect.Descriptor instead.
+func (*QueueStats) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueueStats) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *QueueStats) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *QueueStats) GetActiveCount() uint64 {
+	if x != nil {
+		return x.ActiveCount
+	}
+	return 0
+}
+
+func (x *QueueStats) GetLockedCount() uint64 {
+	if x != nil {
+		return x.LockedCount
+	}
+	return 0
+}
+
+func (x *QueueStats) GetScheduledCount() uint64 {
+	if x != nil {
+		return x.ScheduledCount
+	}
+	return 0
+}
+
+func (x *QueueStats) GetDeadLetteredCount() uint64 {
+	if x != nil {
+		return x.DeadLetteredCount
+	}
+	return 0
+}
+
+func (x *QueueStats) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *QueueStats) GetEnqueueRate() float64 {
+	if x != nil {
+		return x.EnqueueRate
+	}
+	return 0
+}
+
+func (x *QueueStats) GetDequeueRate() float64 {
+	if x != nil {
+		return x.DequeueRate
+	}
+	return 0
+}
+
+func (x *QueueStats) GetOldestMessageAge() *durationpb.Duration {
+	if x != nil {
+		return x.OldestMessageAge
+	}
+	return nil
+}
+
+func (x *QueueStats) GetLockExpirations() uint64 {
+	if x != nil {
+		return x.LockExpirations
+	}
+	return 0
+}
+
+// The QueueStats fields summed over the queues of a namespace, except
+// oldest_message_age, which is the largest among them.
+type NamespaceStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Namespace         string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	QueueCount        uint64                 `protobuf:"varint,2,opt,name=queue_count,json=queueCount,proto3" json:"queue_count,omitempty"`
+	ActiveCount       uint64                 `protobuf:"varint,3,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`
+	LockedCount       uint64                 `protobuf:"varint,4,opt,name=locked_count,json=lockedCount,proto3" json:"locked_count,omitempty"`
+	ScheduledCount    uint64                 `protobuf:"varint,5,opt,name=scheduled_count,json=scheduledCount,proto3" json:"scheduled_count,omitempty"`
+	DeadLetteredCount uint64                 `protobuf:"varint,6,opt,name=dead_lettered_count,json=deadLetteredCount,proto3" json:"dead_lettered_count,omitempty"`
+	TotalBytes        uint64                 `protobuf:"varint,7,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	EnqueueRate       float64                `protobuf:"fixed64,8,opt,name=enqueue_rate,json=enqueueRate,proto3" json:"enqueue_rate,omitempty"`
+	DequeueRate       float64                `protobuf:"fixed64,9,opt,name=dequeue_rate,json=dequeueRate,proto3" json:"dequeue_rate,omitempty"`
+	OldestMessageAge  *durationpb.Duration   `protobuf:"bytes,10,opt,name=oldest_message_age,json=oldestMessageAge,proto3" json:"oldest_message_age,omitempty"`
+	LockExpirations   uint64                 `protobuf:"varint,11,opt,name=lock_expirations,json=lockExpirations,proto3" json:"lock_expirations,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NamespaceStats) Reset() {
+	*x = NamespaceStats{}
+	mi := &file_proto_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceStats) ProtoMessage() {}
+
+func (x *NamespaceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceStats.ProtoReflect.Descriptor instead.
+func (*NamespaceStats) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NamespaceStats) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NamespaceStats) GetQueueCount() uint64 {
+	if x != nil {
+		return x.QueueCount
+	}
+	return 0
+}
+
+func (x *NamespaceStats) GetActiveCount() uint64 {
+	if x != nil {
+		return x.ActiveCount
+	}
+	return 0
+}
+
+func (x *NamespaceStats) GetLockedCount() uint64 {
+	if x != nil {
+		return x.LockedCount
+	}
+	return 0
+}
+
+func (x *NamespaceStats) GetScheduledCount() uint64 {
+	if x != nil {
+		return x.ScheduledCount
+	}
+	return 0
+}
+
+func (x *NamespaceStats) GetDeadLetteredCount() uint64 {
+	if x != nil {
+		return x.DeadLetteredCount
+	}
+	return 0
+}
+
+func (x *NamespaceStats) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *NamespaceStats) GetEnqueueRate() float64 {
+	if x != nil {
+		return x.EnqueueRate
+	}
+	return 0
+}
+
+func (x *NamespaceStats) GetDequeueRate() float64 {
+	if x != nil {
+		return x.DequeueRate
+	}
+	return 0
+}
+
+func (x *NamespaceStats) GetOldestMessageAge() *durationpb.Duration {
+	if x != nil {
+		return x.OldestMessageAge
+	}
+	return nil
+}
+
+func (x *NamespaceStats) GetLockExpirations() uint64 {
+	if x != nil {
+		return x.LockExpirations
+	}
+	return 0
+}
+
+type KokaqStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mirrors the typed stats under the Stat* key names of the Go package,
+	// and carries server-specific counters under other names.
+	Stats  map[string]uint64 `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Status *StatusResponse   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // labels of the namespace or queue described, for attributing metrics
+	Quota  *Quota            `protobuf:"bytes,4,opt,name=quota,proto3" json:"quota,omitempty"`                                                                             // quota of the namespace or queue described
+	// Types that are valid to be assigned to Typed:
+	//
+	//	*KokaqStatsResponse_Queue
+	//	*KokaqStatsResponse_Namespace
+	Typed         isKokaqStatsResponse_Typed `protobuf_oneof:"typed"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KokaqStatsResponse) Reset() {
 	*x = KokaqStatsResponse{}
-	mi := &file_proto_common_proto_msgTypes[1]
+	mi := &file_proto_common_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -237,7 +772,7 @@ func (x *KokaqStatsResponse) String() string {
 func (*KokaqStatsResponse) ProtoMessage() {}
 
 func (x *KokaqStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[1]
+	mi := &file_proto_common_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +785,7 @@ func (x *KokaqStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KokaqStatsResponse.ProtoReflect.Descriptor instead.
 func (*KokaqStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{1}
+	return file_proto_common_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *KokaqStatsResponse) GetStats() map[string]uint64 {
@@ -267,16 +802,135 @@ func (x *KokaqStatsResponse) GetStatus() *StatusResponse {
 	return nil
 }
 
+func (x *KokaqStatsResponse) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *KokaqStatsResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *KokaqStatsResponse) GetTyped() isKokaqStatsResponse_Typed {
+	if x != nil {
+		return x.Typed
+	}
+	return nil
+}
+
+func (x *KokaqStatsResponse) GetQueue() *QueueStats {
+	if x != nil {
+		if x, ok := x.Typed.(*KokaqStatsResponse_Queue); ok {
+			return x.Queue
+		}
+	}
+	return nil
+}
+
+func (x *KokaqStatsResponse) GetNamespace() *NamespaceStats {
+	if x != nil {
+		if x, ok := x.Typed.(*KokaqStatsResponse_Namespace); ok {
+			return x.Namespace
+		}
+	}
+	return nil
+}
+
+type isKokaqStatsResponse_Typed interface {
+	isKokaqStatsResponse_Typed()
+}
+
+type KokaqStatsResponse_Queue struct {
+	Queue *QueueStats `protobuf:"bytes,5,opt,name=queue,proto3,oneof"`
+}
+
+type KokaqStatsResponse_Namespace struct {
+	Namespace *NamespaceStats `protobuf:"bytes,6,opt,name=namespace,proto3,oneof"`
+}
+
+func (*KokaqStatsResponse_Queue) isKokaqStatsResponse_Typed() {}
+
+func (*KokaqStatsResponse_Namespace) isKokaqStatsResponse_Typed() {}
+
+// Stream stats snapshots of a queue, or of a namespace when queue is empty,
+// starting at once and then every interval until the call is cancelled.
+type WatchStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"` // unset lets the server choose; servers may raise it to their minimum
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchStatsRequest) Reset() {
+	*x = WatchStatsRequest{}
+	mi := &file_proto_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStatsRequest) ProtoMessage() {}
+
+func (x *WatchStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStatsRequest.ProtoReflect.Descriptor instead.
+func (*WatchStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchStatsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchStatsRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *WatchStatsRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
 type KokaqNamespaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // see package labels for the key and value syntax
+	Quota         *Quota                 `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KokaqNamespaceRequest) Reset() {
 	*x = KokaqNamespaceRequest{}
-	mi := &file_proto_common_proto_msgTypes[2]
+	mi := &file_proto_common_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +942,7 @@ func (x *KokaqNamespaceRequest) String() string {
 func (*KokaqNamespaceRequest) ProtoMessage() {}
 
 func (x *KokaqNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[2]
+	mi := &file_proto_common_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,14 +955,28 @@ func (x *KokaqNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KokaqNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*KokaqNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{2}
+	return file_proto_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KokaqNamespaceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
 }
 
-func (x *KokaqNamespaceRequest) GetNamespace() string {
+func (x *KokaqNamespaceRequest) GetLabels() map[string]string {
 	if x != nil {
-		return x.Namespace
+		return x.Labels
 	}
-	return ""
+	return nil
+}
+
+func (x *KokaqNamespaceRequest) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
 }
 
 type KokaqNamespaceResponse struct {
@@ -316,13 +984,15 @@ type KokaqNamespaceResponse struct {
 	Namespace       string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	TotalQueueCount uint64                 `protobuf:"varint,2,opt,name=total_queue_count,json=totalQueueCount,proto3" json:"total_queue_count,omitempty"`
 	CreatedOn       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
+	Labels          map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Quota           *Quota                 `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *KokaqNamespaceResponse) Reset() {
 	*x = KokaqNamespaceResponse{}
-	mi := &file_proto_common_proto_msgTypes[3]
+	mi := &file_proto_common_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +1004,7 @@ func (x *KokaqNamespaceResponse) String() string {
 func (*KokaqNamespaceResponse) ProtoMessage() {}
 
 func (x *KokaqNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[3]
+	mi := &file_proto_common_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +1017,7 @@ func (x *KokaqNamespaceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KokaqNamespaceResponse.ProtoReflect.Descriptor instead.
 func (*KokaqNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{3}
+	return file_proto_common_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *KokaqNamespaceResponse) GetNamespace() string {
@@ -371,24 +1041,70 @@ func (x *KokaqNamespaceResponse) GetCreatedOn() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *KokaqNamespaceResponse) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *KokaqNamespaceResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
 type KokaqQueueRequest struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	Queue                    string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	Namespace                string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	CreatedOn                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
-	DefaultExpiry            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=default_expiry,json=defaultExpiry,proto3" json:"default_expiry,omitempty"`
-	DefaultVisibilityTimeout uint32                 `protobuf:"varint,5,opt,name=default_visibility_timeout,json=defaultVisibilityTimeout,proto3" json:"default_visibility_timeout,omitempty"`
-	MaxDequeueCount          uint32                 `protobuf:"varint,6,opt,name=max_dequeue_count,json=maxDequeueCount,proto3" json:"max_dequeue_count,omitempty"`
-	MaxPriority              uint64                 `protobuf:"varint,7,opt,name=max_priority,json=maxPriority,proto3" json:"max_priority,omitempty"`
-	MinPriority              uint64                 `protobuf:"varint,8,opt,name=min_priority,json=minPriority,proto3" json:"min_priority,omitempty"`
-	EnableDeadLetter         bool                   `protobuf:"varint,9,opt,name=enable_dead_letter,json=enableDeadLetter,proto3" json:"enable_dead_letter,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Queue     string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	CreatedOn *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
+	// Deprecated: holds a duration encoded as a timestamp; use default_time_to_live.
+	//
+	// Deprecated: Marked as deprecated in proto/common.proto.
+	DefaultExpiry *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=default_expiry,json=defaultExpiry,proto3" json:"default_expiry,omitempty"`
+	// Deprecated: in seconds; use default_lock_timeout.
+	//
+	// Deprecated: Marked as deprecated in proto/common.proto.
+	DefaultVisibilityTimeout uint32 `protobuf:"varint,5,opt,name=default_visibility_timeout,json=defaultVisibilityTimeout,proto3" json:"default_visibility_timeout,omitempty"`
+	MaxDequeueCount          uint32 `protobuf:"varint,6,opt,name=max_dequeue_count,json=maxDequeueCount,proto3" json:"max_dequeue_count,omitempty"`
+	MaxPriority              uint64 `protobuf:"varint,7,opt,name=max_priority,json=maxPriority,proto3" json:"max_priority,omitempty"`
+	MinPriority              uint64 `protobuf:"varint,8,opt,name=min_priority,json=minPriority,proto3" json:"min_priority,omitempty"`
+	EnableDeadLetter         bool   `protobuf:"varint,9,opt,name=enable_dead_letter,json=enableDeadLetter,proto3" json:"enable_dead_letter,omitempty"`
+	// Time a message stays available when it carries no time_to_live of its own.
+	// Takes precedence over default_expiry when both are set.
+	DefaultTimeToLive *durationpb.Duration `protobuf:"bytes,10,opt,name=default_time_to_live,json=defaultTimeToLive,proto3" json:"default_time_to_live,omitempty"`
+	// While open, an Enqueue repeating a message_id returns the original
+	// EnqueueResponse instead of storing a second copy. Unset disables detection.
+	DuplicateDetectionWindow *durationpb.Duration `protobuf:"bytes,11,opt,name=duplicate_detection_window,json=duplicateDetectionWindow,proto3" json:"duplicate_detection_window,omitempty"`
+	// Lock duration used when a PeekLock does not ask for one.
+	// Takes precedence over default_visibility_timeout when both are set.
+	DefaultLockTimeout *durationpb.Duration `protobuf:"bytes,12,opt,name=default_lock_timeout,json=defaultLockTimeout,proto3" json:"default_lock_timeout,omitempty"`
+	// Applied on Nack and on lock expiry. Each such failure increments the
+	// message retry_count; once it reaches max_dequeue_count the message is
+	// dead-lettered with FailureReason.MAX_RETRY_EXCEEDED.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,13,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// Auto-forwarding to queues of the same namespace: every message enqueued
+	// here moves on to forward_to, every dead letter to forward_dead_letters_to.
+	// Each move increments the message hop_count; past the hop limit the
+	// message is dead-lettered here with FailureReason.FORWARDING_LOOP.
+	ForwardTo            string `protobuf:"bytes,14,opt,name=forward_to,json=forwardTo,proto3" json:"forward_to,omitempty"`
+	ForwardDeadLettersTo string `protobuf:"bytes,15,opt,name=forward_dead_letters_to,json=forwardDeadLettersTo,proto3" json:"forward_dead_letters_to,omitempty"`
+	// Set at creation, then changed only through SetQueueStatus.
+	Status QueueStatus `protobuf:"varint,16,opt,name=status,proto3,enum=proto.QueueStatus" json:"status,omitempty"`
+	// Free-form tags such as the owning team or cost center, see package labels
+	// for the key and value syntax. An update mask path of "labels" replaces
+	// the whole map.
+	Labels        map[string]string `protobuf:"bytes,17,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Quota         *Quota            `protobuf:"bytes,18,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KokaqQueueRequest) Reset() {
 	*x = KokaqQueueRequest{}
-	mi := &file_proto_common_proto_msgTypes[4]
+	mi := &file_proto_common_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +1116,7 @@ func (x *KokaqQueueRequest) String() string {
 func (*KokaqQueueRequest) ProtoMessage() {}
 
 func (x *KokaqQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[4]
+	mi := &file_proto_common_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +1129,7 @@ func (x *KokaqQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KokaqQueueRequest.ProtoReflect.Descriptor instead.
 func (*KokaqQueueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{4}
+	return file_proto_common_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *KokaqQueueRequest) GetQueue() string {
@@ -437,6 +1153,7 @@ func (x *KokaqQueueRequest) GetCreatedOn() *timestamppb.Timestamp {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/common.proto.
 func (x *KokaqQueueRequest) GetDefaultExpiry() *timestamppb.Timestamp {
 	if x != nil {
 		return x.DefaultExpiry
@@ -444,6 +1161,7 @@ func (x *KokaqQueueRequest) GetDefaultExpiry() *timestamppb.Timestamp {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/common.proto.
 func (x *KokaqQueueRequest) GetDefaultVisibilityTimeout() uint32 {
 	if x != nil {
 		return x.DefaultVisibilityTimeout
@@ -479,6 +1197,69 @@ func (x *KokaqQueueRequest) GetEnableDeadLetter() bool {
 	return false
 }
 
+func (x *KokaqQueueRequest) GetDefaultTimeToLive() *durationpb.Duration {
+	if x != nil {
+		return x.DefaultTimeToLive
+	}
+	return nil
+}
+
+func (x *KokaqQueueRequest) GetDuplicateDetectionWindow() *durationpb.Duration {
+	if x != nil {
+		return x.DuplicateDetectionWindow
+	}
+	return nil
+}
+
+func (x *KokaqQueueRequest) GetDefaultLockTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DefaultLockTimeout
+	}
+	return nil
+}
+
+func (x *KokaqQueueRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *KokaqQueueRequest) GetForwardTo() string {
+	if x != nil {
+		return x.ForwardTo
+	}
+	return ""
+}
+
+func (x *KokaqQueueRequest) GetForwardDeadLettersTo() string {
+	if x != nil {
+		return x.ForwardDeadLettersTo
+	}
+	return ""
+}
+
+func (x *KokaqQueueRequest) GetStatus() QueueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QueueStatus_QUEUE_STATUS_ACTIVE
+}
+
+func (x *KokaqQueueRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *KokaqQueueRequest) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
 type KokaqQueueResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Request        *KokaqQueueRequest     `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
@@ -486,13 +1267,14 @@ type KokaqQueueResponse struct {
 	TotalNodeCount uint64                 `protobuf:"varint,3,opt,name=total_node_count,json=totalNodeCount,proto3" json:"total_node_count,omitempty"`
 	TotalPageCount uint64                 `protobuf:"varint,4,opt,name=total_page_count,json=totalPageCount,proto3" json:"total_page_count,omitempty"`
 	CreatedOn      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
+	Etag           string                 `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"` // changes whenever the queue settings change
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *KokaqQueueResponse) Reset() {
 	*x = KokaqQueueResponse{}
-	mi := &file_proto_common_proto_msgTypes[5]
+	mi := &file_proto_common_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -504,7 +1286,7 @@ func (x *KokaqQueueResponse) String() string {
 func (*KokaqQueueResponse) ProtoMessage() {}
 
 func (x *KokaqQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[5]
+	mi := &file_proto_common_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +1299,7 @@ func (x *KokaqQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KokaqQueueResponse.ProtoReflect.Descriptor instead.
 func (*KokaqQueueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{5}
+	return file_proto_common_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *KokaqQueueResponse) GetRequest() *KokaqQueueRequest {
@@ -555,46 +1337,395 @@ func (x *KokaqQueueResponse) GetCreatedOn() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *KokaqQueueResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// A topic fans each message enqueued to it out to its subscriptions
+type KokaqTopicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KokaqTopicRequest) Reset() {
+	*x = KokaqTopicRequest{}
+	mi := &file_proto_common_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KokaqTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KokaqTopicRequest) ProtoMessage() {}
+
+func (x *KokaqTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KokaqTopicRequest.ProtoReflect.Descriptor instead.
+func (*KokaqTopicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *KokaqTopicRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *KokaqTopicRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type KokaqTopicResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Request           *KokaqTopicRequest     `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	SubscriptionCount uint64                 `protobuf:"varint,2,opt,name=subscription_count,json=subscriptionCount,proto3" json:"subscription_count,omitempty"`
+	CreatedOn         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *KokaqTopicResponse) Reset() {
+	*x = KokaqTopicResponse{}
+	mi := &file_proto_common_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KokaqTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KokaqTopicResponse) ProtoMessage() {}
+
+func (x *KokaqTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KokaqTopicResponse.ProtoReflect.Descriptor instead.
+func (*KokaqTopicResponse) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *KokaqTopicResponse) GetRequest() *KokaqTopicRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *KokaqTopicResponse) GetSubscriptionCount() uint64 {
+	if x != nil {
+		return x.SubscriptionCount
+	}
+	return 0
+}
+
+func (x *KokaqTopicResponse) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+// A subscription copies the messages of a topic into a queue of the same namespace
+type KokaqSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  string                 `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,4,opt,name=queue,proto3" json:"queue,omitempty"`
+	Filter        string                 `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"` // only copy messages matching this expression, see package filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KokaqSubscriptionRequest) Reset() {
+	*x = KokaqSubscriptionRequest{}
+	mi := &file_proto_common_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KokaqSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KokaqSubscriptionRequest) ProtoMessage() {}
+
+func (x *KokaqSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KokaqSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*KokaqSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *KokaqSubscriptionRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *KokaqSubscriptionRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *KokaqSubscriptionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *KokaqSubscriptionRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *KokaqSubscriptionRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type KokaqSubscriptionResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Request       *KokaqSubscriptionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	CreatedOn     *timestamppb.Timestamp    `protobuf:"bytes,2,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KokaqSubscriptionResponse) Reset() {
+	*x = KokaqSubscriptionResponse{}
+	mi := &file_proto_common_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KokaqSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KokaqSubscriptionResponse) ProtoMessage() {}
+
+func (x *KokaqSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KokaqSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*KokaqSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *KokaqSubscriptionResponse) GetRequest() *KokaqSubscriptionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *KokaqSubscriptionResponse) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
 var File_proto_common_proto protoreflect.FileDescriptor
 
 const file_proto_common_proto_rawDesc = "" +
 	"\n" +
-	"\x12proto/common.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\"R\n" +
+	"\x12proto/common.proto\x12\x05proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"R\n" +
 	"\x0eStatusResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12&\n" +
-	"\x05error\x18\x02 \x01(\x0e2\x10.proto.ErrorCodeR\x05error\"\xb9\x01\n" +
+	"\x05error\x18\x02 \x01(\x0e2\x10.proto.ErrorCodeR\x05error\"\xeb\x01\n" +
+	"\vRetryPolicy\x12,\n" +
+	"\abackoff\x18\x01 \x01(\x0e2\x12.proto.BackoffKindR\abackoff\x12>\n" +
+	"\rinitial_delay\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\finitialDelay\x126\n" +
+	"\tmax_delay\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bmaxDelay\x12\x16\n" +
+	"\x06jitter\x18\x04 \x01(\x01R\x06jitter\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x05 \x01(\x01R\n" +
+	"multiplier\"\xc5\x01\n" +
+	"\x05Quota\x12\x1d\n" +
+	"\n" +
+	"max_queues\x18\x01 \x01(\x04R\tmaxQueues\x12!\n" +
+	"\fmax_messages\x18\x02 \x01(\x04R\vmaxMessages\x12&\n" +
+	"\x0fmax_total_bytes\x18\x03 \x01(\x04R\rmaxTotalBytes\x12(\n" +
+	"\x10max_message_size\x18\x04 \x01(\x04R\x0emaxMessageSize\x12(\n" +
+	"\x10max_enqueue_rate\x18\x05 \x01(\rR\x0emaxEnqueueRate\"\xba\x03\n" +
+	"\n" +
+	"QueueStats\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12!\n" +
+	"\factive_count\x18\x03 \x01(\x04R\vactiveCount\x12!\n" +
+	"\flocked_count\x18\x04 \x01(\x04R\vlockedCount\x12'\n" +
+	"\x0fscheduled_count\x18\x05 \x01(\x04R\x0escheduledCount\x12.\n" +
+	"\x13dead_lettered_count\x18\x06 \x01(\x04R\x11deadLetteredCount\x12\x1f\n" +
+	"\vtotal_bytes\x18\a \x01(\x04R\n" +
+	"totalBytes\x12!\n" +
+	"\fenqueue_rate\x18\b \x01(\x01R\venqueueRate\x12!\n" +
+	"\fdequeue_rate\x18\t \x01(\x01R\vdequeueRate\x12G\n" +
+	"\x12oldest_message_age\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x10oldestMessageAge\x12)\n" +
+	"\x10lock_expirations\x18\v \x01(\x04R\x0flockExpirations\"\xc9\x03\n" +
+	"\x0eNamespaceStats\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vqueue_count\x18\x02 \x01(\x04R\n" +
+	"queueCount\x12!\n" +
+	"\factive_count\x18\x03 \x01(\x04R\vactiveCount\x12!\n" +
+	"\flocked_count\x18\x04 \x01(\x04R\vlockedCount\x12'\n" +
+	"\x0fscheduled_count\x18\x05 \x01(\x04R\x0escheduledCount\x12.\n" +
+	"\x13dead_lettered_count\x18\x06 \x01(\x04R\x11deadLetteredCount\x12\x1f\n" +
+	"\vtotal_bytes\x18\a \x01(\x04R\n" +
+	"totalBytes\x12!\n" +
+	"\fenqueue_rate\x18\b \x01(\x01R\venqueueRate\x12!\n" +
+	"\fdequeue_rate\x18\t \x01(\x01R\vdequeueRate\x12G\n" +
+	"\x12oldest_message_age\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x10oldestMessageAge\x12)\n" +
+	"\x10lock_expirations\x18\v \x01(\x04R\x0flockExpirations\"\xc2\x03\n" +
 	"\x12KokaqStatsResponse\x12:\n" +
 	"\x05stats\x18\x01 \x03(\v2$.proto.KokaqStatsResponse.StatsEntryR\x05stats\x12-\n" +
-	"\x06status\x18\x02 \x01(\v2\x15.proto.StatusResponseR\x06status\x1a8\n" +
+	"\x06status\x18\x02 \x01(\v2\x15.proto.StatusResponseR\x06status\x12=\n" +
+	"\x06labels\x18\x03 \x03(\v2%.proto.KokaqStatsResponse.LabelsEntryR\x06labels\x12\"\n" +
+	"\x05quota\x18\x04 \x01(\v2\f.proto.QuotaR\x05quota\x12)\n" +
+	"\x05queue\x18\x05 \x01(\v2\x11.proto.QueueStatsH\x00R\x05queue\x125\n" +
+	"\tnamespace\x18\x06 \x01(\v2\x15.proto.NamespaceStatsH\x00R\tnamespace\x1a8\n" +
 	"\n" +
 	"StatsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"5\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05typed\"~\n" +
+	"\x11WatchStatsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x125\n" +
+	"\binterval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\binterval\"\xd6\x01\n" +
 	"\x15KokaqNamespaceRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\x9d\x01\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12@\n" +
+	"\x06labels\x18\x02 \x03(\v2(.proto.KokaqNamespaceRequest.LabelsEntryR\x06labels\x12\"\n" +
+	"\x05quota\x18\x03 \x01(\v2\f.proto.QuotaR\x05quota\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbf\x02\n" +
 	"\x16KokaqNamespaceResponse\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12*\n" +
 	"\x11total_queue_count\x18\x02 \x01(\x04R\x0ftotalQueueCount\x129\n" +
 	"\n" +
-	"created_on\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\"\xa3\x03\n" +
+	"created_on\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x12A\n" +
+	"\x06labels\x18\x04 \x03(\v2).proto.KokaqNamespaceResponse.LabelsEntryR\x06labels\x12\"\n" +
+	"\x05quota\x18\x05 \x01(\v2\f.proto.QuotaR\x05quota\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf3\a\n" +
 	"\x11KokaqQueueRequest\x12\x14\n" +
 	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x129\n" +
 	"\n" +
-	"created_on\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x12A\n" +
-	"\x0edefault_expiry\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rdefaultExpiry\x12<\n" +
-	"\x1adefault_visibility_timeout\x18\x05 \x01(\rR\x18defaultVisibilityTimeout\x12*\n" +
+	"created_on\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x12E\n" +
+	"\x0edefault_expiry\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x02\x18\x01R\rdefaultExpiry\x12@\n" +
+	"\x1adefault_visibility_timeout\x18\x05 \x01(\rB\x02\x18\x01R\x18defaultVisibilityTimeout\x12*\n" +
 	"\x11max_dequeue_count\x18\x06 \x01(\rR\x0fmaxDequeueCount\x12!\n" +
 	"\fmax_priority\x18\a \x01(\x04R\vmaxPriority\x12!\n" +
 	"\fmin_priority\x18\b \x01(\x04R\vminPriority\x12,\n" +
-	"\x12enable_dead_letter\x18\t \x01(\bR\x10enableDeadLetter\"\xf2\x01\n" +
+	"\x12enable_dead_letter\x18\t \x01(\bR\x10enableDeadLetter\x12J\n" +
+	"\x14default_time_to_live\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x11defaultTimeToLive\x12W\n" +
+	"\x1aduplicate_detection_window\x18\v \x01(\v2\x19.google.protobuf.DurationR\x18duplicateDetectionWindow\x12K\n" +
+	"\x14default_lock_timeout\x18\f \x01(\v2\x19.google.protobuf.DurationR\x12defaultLockTimeout\x125\n" +
+	"\fretry_policy\x18\r \x01(\v2\x12.proto.RetryPolicyR\vretryPolicy\x12\x1d\n" +
+	"\n" +
+	"forward_to\x18\x0e \x01(\tR\tforwardTo\x125\n" +
+	"\x17forward_dead_letters_to\x18\x0f \x01(\tR\x14forwardDeadLettersTo\x12*\n" +
+	"\x06status\x18\x10 \x01(\x0e2\x12.proto.QueueStatusR\x06status\x12<\n" +
+	"\x06labels\x18\x11 \x03(\v2$.proto.KokaqQueueRequest.LabelsEntryR\x06labels\x12\"\n" +
+	"\x05quota\x18\x12 \x01(\v2\f.proto.QuotaR\x05quota\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x02\n" +
 	"\x12KokaqQueueResponse\x122\n" +
 	"\arequest\x18\x01 \x01(\v2\x18.proto.KokaqQueueRequestR\arequest\x12\x19\n" +
 	"\bshard_id\x18\x02 \x01(\x04R\ashardId\x12(\n" +
 	"\x10total_node_count\x18\x03 \x01(\x04R\x0etotalNodeCount\x12(\n" +
 	"\x10total_page_count\x18\x04 \x01(\x04R\x0etotalPageCount\x129\n" +
 	"\n" +
-	"created_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn*\xde\x01\n" +
+	"created_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etag\"G\n" +
+	"\x11KokaqTopicRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\xb2\x01\n" +
+	"\x12KokaqTopicResponse\x122\n" +
+	"\arequest\x18\x01 \x01(\v2\x18.proto.KokaqTopicRequestR\arequest\x12-\n" +
+	"\x12subscription_count\x18\x02 \x01(\x04R\x11subscriptionCount\x129\n" +
+	"\n" +
+	"created_on\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\"\xa0\x01\n" +
+	"\x18KokaqSubscriptionRequest\x12\"\n" +
+	"\fsubscription\x18\x01 \x01(\tR\fsubscription\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x04 \x01(\tR\x05queue\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\"\x91\x01\n" +
+	"\x19KokaqSubscriptionResponse\x129\n" +
+	"\arequest\x18\x01 \x01(\v2\x1f.proto.KokaqSubscriptionRequestR\arequest\x129\n" +
+	"\n" +
+	"created_on\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn*\xa3\x02\n" +
 	"\tErrorCode\x12\x0e\n" +
 	"\n" +
 	"ERROR_NONE\x10\x00\x12\x13\n" +
@@ -605,7 +1736,11 @@ const file_proto_common_proto_rawDesc = "" +
 	"\x15ERROR_SHARD_UNHEALTHY\x10\x05\x12\x11\n" +
 	"\rERROR_TIMEOUT\x10\x06\x12\x1a\n" +
 	"\x16ERROR_INVALID_ARGUMENT\x10\a\x12\x1c\n" +
-	"\x18ERROR_DEPENDENCY_FAILURE\x10\b*\x96\x02\n" +
+	"\x18ERROR_DEPENDENCY_FAILURE\x10\b\x12\x15\n" +
+	"\x11ERROR_TXN_ABORTED\x10\t\x12\x12\n" +
+	"\x0eERROR_CONFLICT\x10\n" +
+	"\x12\x18\n" +
+	"\x14ERROR_QUOTA_EXCEEDED\x10\v*\xab\x02\n" +
 	"\rFailureReason\x12\x1f\n" +
 	"\x1bMESSAGE_FAILURE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fHANDLER_TIMEOUT\x10\x01\x12\x16\n" +
@@ -618,7 +1753,17 @@ const file_proto_common_proto_rawDesc = "" +
 	"\x0fQUEUE_NOT_FOUND\x10\b\x12\x1b\n" +
 	"\x17DROPPED_DUE_TO_SHUTDOWN\x10\t\x12\x1f\n" +
 	"\x1bVISIBILITY_TIMEOUT_EXCEEDED\x10\n" +
-	"B!Z\x1fgithub.com/kokaq/protocol/protob\x06proto3"
+	"\x12\x13\n" +
+	"\x0fFORWARDING_LOOP\x10\v*L\n" +
+	"\vBackoffKind\x12\x10\n" +
+	"\fBACKOFF_NONE\x10\x00\x12\x12\n" +
+	"\x0eBACKOFF_LINEAR\x10\x01\x12\x17\n" +
+	"\x13BACKOFF_EXPONENTIAL\x10\x02*\x84\x01\n" +
+	"\vQueueStatus\x12\x17\n" +
+	"\x13QUEUE_STATUS_ACTIVE\x10\x00\x12\x1e\n" +
+	"\x1aQUEUE_STATUS_SEND_DISABLED\x10\x01\x12!\n" +
+	"\x1dQUEUE_STATUS_RECEIVE_DISABLED\x10\x02\x12\x19\n" +
+	"\x15QUEUE_STATUS_DISABLED\x10\x03B!Z\x1fgithub.com/kokaq/protocol/protob\x06proto3"
 
 var (
 	file_proto_common_proto_rawDescOnce sync.Once
@@ -632,34 +1777,75 @@ func file_proto_common_proto_rawDescGZIP() []byte {
 	return file_proto_common_proto_rawDescData
 }
 
-var file_proto_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_common_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_common_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_proto_common_proto_goTypes = []any{
-	(ErrorCode)(0),                 // 0: proto.ErrorCode
-	(FailureReason)(0),             // 1: proto.FailureReason
-	(*StatusResponse)(nil),         // 2: proto.StatusResponse
-	(*KokaqStatsResponse)(nil),     // 3: proto.KokaqStatsResponse
-	(*KokaqNamespaceRequest)(nil),  // 4: proto.KokaqNamespaceRequest
-	(*KokaqNamespaceResponse)(nil), // 5: proto.KokaqNamespaceResponse
-	(*KokaqQueueRequest)(nil),      // 6: proto.KokaqQueueRequest
-	(*KokaqQueueResponse)(nil),     // 7: proto.KokaqQueueResponse
-	nil,                            // 8: proto.KokaqStatsResponse.StatsEntry
-	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
+	(ErrorCode)(0),                    // 0: proto.ErrorCode
+	(FailureReason)(0),                // 1: proto.FailureReason
+	(BackoffKind)(0),                  // 2: proto.BackoffKind
+	(QueueStatus)(0),                  // 3: proto.QueueStatus
+	(*StatusResponse)(nil),            // 4: proto.StatusResponse
+	(*RetryPolicy)(nil),               // 5: proto.RetryPolicy
+	(*Quota)(nil),                     // 6: proto.Quota
+	(*QueueStats)(nil),                // 7: proto.QueueStats
+	(*NamespaceStats)(nil),            // 8: proto.NamespaceStats
+	(*KokaqStatsResponse)(nil),        // 9: proto.KokaqStatsResponse
+	(*WatchStatsRequest)(nil),         // 10: proto.WatchStatsRequest
+	(*KokaqNamespaceRequest)(nil),     // 11: proto.KokaqNamespaceRequest
+	(*KokaqNamespaceResponse)(nil),    // 12: proto.KokaqNamespaceResponse
+	(*KokaqQueueRequest)(nil),         // 13: proto.KokaqQueueRequest
+	(*KokaqQueueResponse)(nil),        // 14: proto.KokaqQueueResponse
+	(*KokaqTopicRequest)(nil),         // 15: proto.KokaqTopicRequest
+	(*KokaqTopicResponse)(nil),        // 16: proto.KokaqTopicResponse
+	(*KokaqSubscriptionRequest)(nil),  // 17: proto.KokaqSubscriptionRequest
+	(*KokaqSubscriptionResponse)(nil), // 18: proto.KokaqSubscriptionResponse
+	nil,                               // 19: proto.KokaqStatsResponse.StatsEntry
+	nil,                               // 20: proto.KokaqStatsResponse.LabelsEntry
+	nil,                               // 21: proto.KokaqNamespaceRequest.LabelsEntry
+	nil,                               // 22: proto.KokaqNamespaceResponse.LabelsEntry
+	nil,                               // 23: proto.KokaqQueueRequest.LabelsEntry
+	(*durationpb.Duration)(nil),       // 24: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),     // 25: google.protobuf.Timestamp
 }
 var file_proto_common_proto_depIdxs = []int32{
-	0, // 0: proto.StatusResponse.error:type_name -> proto.ErrorCode
-	8, // 1: proto.KokaqStatsResponse.stats:type_name -> proto.KokaqStatsResponse.StatsEntry
-	2, // 2: proto.KokaqStatsResponse.status:type_name -> proto.StatusResponse
-	9, // 3: proto.KokaqNamespaceResponse.created_on:type_name -> google.protobuf.Timestamp
-	9, // 4: proto.KokaqQueueRequest.created_on:type_name -> google.protobuf.Timestamp
-	9, // 5: proto.KokaqQueueRequest.default_expiry:type_name -> google.protobuf.Timestamp
-	6, // 6: proto.KokaqQueueResponse.request:type_name -> proto.KokaqQueueRequest
-	9, // 7: proto.KokaqQueueResponse.created_on:type_name -> google.protobuf.Timestamp
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: proto.StatusResponse.error:type_name -> proto.ErrorCode
+	2,  // 1: proto.RetryPolicy.backoff:type_name -> proto.BackoffKind
+	24, // 2: proto.RetryPolicy.initial_delay:type_name -> google.protobuf.Duration
+	24, // 3: proto.RetryPolicy.max_delay:type_name -> google.protobuf.Duration
+	24, // 4: proto.QueueStats.oldest_message_age:type_name -> google.protobuf.Duration
+	24, // 5: proto.NamespaceStats.oldest_message_age:type_name -> google.protobuf.Duration
+	19, // 6: proto.KokaqStatsResponse.stats:type_name -> proto.KokaqStatsResponse.StatsEntry
+	4,  // 7: proto.KokaqStatsResponse.status:type_name -> proto.StatusResponse
+	20, // 8: proto.KokaqStatsResponse.labels:type_name -> proto.KokaqStatsResponse.LabelsEntry
+	6,  // 9: proto.KokaqStatsResponse.quota:type_name -> proto.Quota
+	7,  // 10: proto.KokaqStatsResponse.queue:type_name -> proto.QueueStats
+	8,  // 11: proto.KokaqStatsResponse.namespace:type_name -> proto.NamespaceStats
+	24, // 12: proto.WatchStatsRequest.interval:type_name -> google.protobuf.Duration
+	21, // 13: proto.KokaqNamespaceRequest.labels:type_name -> proto.KokaqNamespaceRequest.LabelsEntry
+	6,  // 14: proto.KokaqNamespaceRequest.quota:type_name -> proto.Quota
+	25, // 15: proto.KokaqNamespaceResponse.created_on:type_name -> google.protobuf.Timestamp
+	22, // 16: proto.KokaqNamespaceResponse.labels:type_name -> proto.KokaqNamespaceResponse.LabelsEntry
+	6,  // 17: proto.KokaqNamespaceResponse.quota:type_name -> proto.Quota
+	25, // 18: proto.KokaqQueueRequest.created_on:type_name -> google.protobuf.Timestamp
+	25, // 19: proto.KokaqQueueRequest.default_expiry:type_name -> google.protobuf.Timestamp
+	24, // 20: proto.KokaqQueueRequest.default_time_to_live:type_name -> google.protobuf.Duration
+	24, // 21: proto.KokaqQueueRequest.duplicate_detection_window:type_name -> google.protobuf.Duration
+	24, // 22: proto.KokaqQueueRequest.default_lock_timeout:type_name -> google.protobuf.Duration
+	5,  // 23: proto.KokaqQueueRequest.retry_policy:type_name -> proto.RetryPolicy
+	3,  // 24: proto.KokaqQueueRequest.status:type_name -> proto.QueueStatus
+	23, // 25: proto.KokaqQueueRequest.labels:type_name -> proto.KokaqQueueRequest.LabelsEntry
+	6,  // 26: proto.KokaqQueueRequest.quota:type_name -> proto.Quota
+	13, // 27: proto.KokaqQueueResponse.request:type_name -> proto.KokaqQueueRequest
+	25, // 28: proto.KokaqQueueResponse.created_on:type_name -> google.protobuf.Timestamp
+	15, // 29: proto.KokaqTopicResponse.request:type_name -> proto.KokaqTopicRequest
+	25, // 30: proto.KokaqTopicResponse.created_on:type_name -> google.protobuf.Timestamp
+	17, // 31: proto.KokaqSubscriptionResponse.request:type_name -> proto.KokaqSubscriptionRequest
+	25, // 32: proto.KokaqSubscriptionResponse.created_on:type_name -> google.protobuf.Timestamp
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_proto_common_proto_init() }
@@ -667,13 +1853,17 @@ func file_proto_common_proto_init() {
 	if File_proto_common_proto != nil {
 		return
 	}
+	file_proto_common_proto_msgTypes[5].OneofWrappers = []any{
+		(*KokaqStatsResponse_Queue)(nil),
+		(*KokaqStatsResponse_Namespace)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_common_proto_rawDesc), len(file_proto_common_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
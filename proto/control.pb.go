@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -133,29 +134,519 @@ func (x *GetDataplaneResponse) GetAddress() string {
 	return ""
 }
 
+// Change settings of an existing queue. namespace, queue, created_on and
+// status are immutable; SetQueueStatus changes the status. An empty
+// update_mask replaces every mutable setting.
+type UpdateQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *KokaqQueueRequest     `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // paths within KokaqQueueRequest
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`                               // required, from the last read; a stale etag fails with ERROR_CONFLICT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQueueRequest) Reset() {
+	*x = UpdateQueueRequest{}
+	mi := &file_proto_control_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQueueRequest) ProtoMessage() {}
+
+func (x *UpdateQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQueueRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQueueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_control_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateQueueRequest) GetQueue() *KokaqQueueRequest {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *UpdateQueueRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateQueueRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Change the status of a queue, e.g. to drain producers before a migration
+type SetQueueStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Status        QueueStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=proto.QueueStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQueueStatusRequest) Reset() {
+	*x = SetQueueStatusRequest{}
+	mi := &file_proto_control_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQueueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQueueStatusRequest) ProtoMessage() {}
+
+func (x *SetQueueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQueueStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetQueueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_control_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetQueueStatusRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetQueueStatusRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *SetQueueStatusRequest) GetStatus() QueueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return QueueStatus_QUEUE_STATUS_ACTIVE
+}
+
+// Enumerate namespaces in name order, page by page
+type ListNamespacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      uint32                 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`               // 0 lets the server choose
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`             // next_page_token of the previous page, empty for the first
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`                                    // only names starting with prefix, must not change between pages
+	LabelSelector string                 `protobuf:"bytes,4,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"` // only matching labels, see package labels; must not change between pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacesRequest) Reset() {
+	*x = ListNamespacesRequest{}
+	mi := &file_proto_control_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacesRequest) ProtoMessage() {}
+
+func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_control_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListNamespacesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNamespacesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNamespacesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListNamespacesRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type ListNamespacesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Namespaces    []*KokaqNamespaceResponse `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	NextPageToken string                    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	Status        *StatusResponse           `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacesResponse) Reset() {
+	*x = ListNamespacesResponse{}
+	mi := &file_proto_control_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacesResponse) ProtoMessage() {}
+
+func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacesResponse.ProtoReflect.Descriptor instead.
+func (*ListNamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_control_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListNamespacesResponse) GetNamespaces() []*KokaqNamespaceResponse {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *ListNamespacesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListNamespacesResponse) GetStatus() *StatusResponse {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Enumerate the queues of a namespace in name order, page by page
+type ListQueuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`               // 0 lets the server choose
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`             // next_page_token of the previous page, empty for the first
+	Prefix        string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`                                    // only names starting with prefix, must not change between pages
+	LabelSelector string                 `protobuf:"bytes,5,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"` // only matching labels, see package labels; must not change between pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesRequest) Reset() {
+	*x = ListQueuesRequest{}
+	mi := &file_proto_control_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesRequest) ProtoMessage() {}
+
+func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_control_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListQueuesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListQueuesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQueuesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListQueuesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListQueuesRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type ListQueuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queues        []*KokaqQueueResponse  `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	Status        *StatusResponse        `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesResponse) Reset() {
+	*x = ListQueuesResponse{}
+	mi := &file_proto_control_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesResponse) ProtoMessage() {}
+
+func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_control_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListQueuesResponse) GetQueues() []*KokaqQueueResponse {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+func (x *ListQueuesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListQueuesResponse) GetStatus() *StatusResponse {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Subscriptions []*KokaqSubscriptionResponse `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Status        *StatusResponse              `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_proto_control_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_control_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*KokaqSubscriptionResponse {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *ListSubscriptionsResponse) GetStatus() *StatusResponse {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
 var File_proto_control_proto protoreflect.FileDescriptor
 
 const file_proto_control_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/control.proto\x12\x05proto\x1a\x12proto/common.proto\"I\n" +
+	"\x13proto/control.proto\x12\x05proto\x1a\x12proto/common.proto\x1a google/protobuf/field_mask.proto\"I\n" +
 	"\x13GetDataplaneRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\"d\n" +
 	"\x14GetDataplaneResponse\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x18\n" +
-	"\aaddress\x18\x03 \x01(\tR\aaddress2\x84\x05\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\"\x95\x01\n" +
+	"\x12UpdateQueueRequest\x12.\n" +
+	"\x05queue\x18\x01 \x01(\v2\x18.proto.KokaqQueueRequestR\x05queue\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"w\n" +
+	"\x15SetQueueStatusRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12*\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x12.proto.QueueStatusR\x06status\"\x92\x01\n" +
+	"\x15ListNamespacesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12%\n" +
+	"\x0elabel_selector\x18\x04 \x01(\tR\rlabelSelector\"\xae\x01\n" +
+	"\x16ListNamespacesResponse\x12=\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2\x1d.proto.KokaqNamespaceResponseR\n" +
+	"namespaces\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12-\n" +
+	"\x06status\x18\x03 \x01(\v2\x15.proto.StatusResponseR\x06status\"\xac\x01\n" +
+	"\x11ListQueuesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12%\n" +
+	"\x0elabel_selector\x18\x05 \x01(\tR\rlabelSelector\"\x9e\x01\n" +
+	"\x12ListQueuesResponse\x121\n" +
+	"\x06queues\x18\x01 \x03(\v2\x19.proto.KokaqQueueResponseR\x06queues\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12-\n" +
+	"\x06status\x18\x03 \x01(\v2\x15.proto.StatusResponseR\x06status\"\x92\x01\n" +
+	"\x19ListSubscriptionsResponse\x12F\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2 .proto.KokaqSubscriptionResponseR\rsubscriptions\x12-\n" +
+	"\x06status\x18\x02 \x01(\v2\x15.proto.StatusResponseR\x06status2\xa2\v\n" +
 	"\x11KokaqControlPlane\x12G\n" +
 	"\fGetDataplane\x12\x1a.proto.GetDataplaneRequest\x1a\x1b.proto.GetDataplaneResponse\x12K\n" +
 	"\fGetNamespace\x12\x1c.proto.KokaqNamespaceRequest\x1a\x1d.proto.KokaqNamespaceResponse\x12K\n" +
 	"\fAddNamespace\x12\x1c.proto.KokaqNamespaceRequest\x1a\x1d.proto.KokaqNamespaceResponse\x12F\n" +
-	"\x0fDeleteNamespace\x12\x1c.proto.KokaqNamespaceRequest\x1a\x15.proto.StatusResponse\x12?\n" +
+	"\x0fDeleteNamespace\x12\x1c.proto.KokaqNamespaceRequest\x1a\x15.proto.StatusResponse\x12M\n" +
+	"\x0eListNamespaces\x12\x1c.proto.ListNamespacesRequest\x1a\x1d.proto.ListNamespacesResponse\x12?\n" +
 	"\bAddQueue\x12\x18.proto.KokaqQueueRequest\x1a\x19.proto.KokaqQueueResponse\x12?\n" +
-	"\bGetQueue\x12\x18.proto.KokaqQueueRequest\x1a\x19.proto.KokaqQueueResponse\x12>\n" +
-	"\vDeleteQueue\x12\x18.proto.KokaqQueueRequest\x1a\x15.proto.StatusResponse\x12=\n" +
+	"\bGetQueue\x12\x18.proto.KokaqQueueRequest\x1a\x19.proto.KokaqQueueResponse\x12C\n" +
+	"\vUpdateQueue\x12\x19.proto.UpdateQueueRequest\x1a\x19.proto.KokaqQueueResponse\x12I\n" +
+	"\x0eSetQueueStatus\x12\x1c.proto.SetQueueStatusRequest\x1a\x19.proto.KokaqQueueResponse\x12>\n" +
+	"\vDeleteQueue\x12\x18.proto.KokaqQueueRequest\x1a\x15.proto.StatusResponse\x12A\n" +
+	"\n" +
+	"ListQueues\x12\x18.proto.ListQueuesRequest\x1a\x19.proto.ListQueuesResponse\x12=\n" +
+	"\n" +
+	"ClearQueue\x12\x18.proto.KokaqQueueRequest\x1a\x15.proto.StatusResponse\x12?\n" +
+	"\bAddTopic\x12\x18.proto.KokaqTopicRequest\x1a\x19.proto.KokaqTopicResponse\x12?\n" +
+	"\bGetTopic\x12\x18.proto.KokaqTopicRequest\x1a\x19.proto.KokaqTopicResponse\x12>\n" +
+	"\vDeleteTopic\x12\x18.proto.KokaqTopicRequest\x1a\x15.proto.StatusResponse\x12T\n" +
+	"\x0fAddSubscription\x12\x1f.proto.KokaqSubscriptionRequest\x1a .proto.KokaqSubscriptionResponse\x12L\n" +
+	"\x12DeleteSubscription\x12\x1f.proto.KokaqSubscriptionRequest\x1a\x15.proto.StatusResponse\x12O\n" +
+	"\x11ListSubscriptions\x12\x18.proto.KokaqTopicRequest\x1a .proto.ListSubscriptionsResponse\x12C\n" +
+	"\bGetStats\x12\x1c.proto.KokaqNamespaceRequest\x1a\x19.proto.KokaqStatsResponse\x12C\n" +
 	"\n" +
-	"ClearQueue\x12\x18.proto.KokaqQueueRequest\x1a\x15.proto.StatusResponse\x12C\n" +
-	"\bGetStats\x12\x1c.proto.KokaqNamespaceRequest\x1a\x19.proto.KokaqStatsResponseB!Z\x1fgithub.com/kokaq/protocol/protob\x06proto3"
+	"WatchStats\x12\x18.proto.WatchStatsRequest\x1a\x19.proto.KokaqStatsResponse0\x01B!Z\x1fgithub.com/kokaq/protocol/protob\x06proto3"
 
 var (
 	file_proto_control_proto_rawDescOnce sync.Once
@@ -169,41 +660,86 @@ func file_proto_control_proto_rawDescGZIP() []byte {
 	return file_proto_control_proto_rawDescData
 }
 
-var file_proto_control_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_control_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_proto_control_proto_goTypes = []any{
-	(*GetDataplaneRequest)(nil),    // 0: proto.GetDataplaneRequest
-	(*GetDataplaneResponse)(nil),   // 1: proto.GetDataplaneResponse
-	(*KokaqNamespaceRequest)(nil),  // 2: proto.KokaqNamespaceRequest
-	(*KokaqQueueRequest)(nil),      // 3: proto.KokaqQueueRequest
-	(*KokaqNamespaceResponse)(nil), // 4: proto.KokaqNamespaceResponse
-	(*StatusResponse)(nil),         // 5: proto.StatusResponse
-	(*KokaqQueueResponse)(nil),     // 6: proto.KokaqQueueResponse
-	(*KokaqStatsResponse)(nil),     // 7: proto.KokaqStatsResponse
+	(*GetDataplaneRequest)(nil),       // 0: proto.GetDataplaneRequest
+	(*GetDataplaneResponse)(nil),      // 1: proto.GetDataplaneResponse
+	(*UpdateQueueRequest)(nil),        // 2: proto.UpdateQueueRequest
+	(*SetQueueStatusRequest)(nil),     // 3: proto.SetQueueStatusRequest
+	(*ListNamespacesRequest)(nil),     // 4: proto.ListNamespacesRequest
+	(*ListNamespacesResponse)(nil),    // 5: proto.ListNamespacesResponse
+	(*ListQueuesRequest)(nil),         // 6: proto.ListQueuesRequest
+	(*ListQueuesResponse)(nil),        // 7: proto.ListQueuesResponse
+	(*ListSubscriptionsResponse)(nil), // 8: proto.ListSubscriptionsResponse
+	(*KokaqQueueRequest)(nil),         // 9: proto.KokaqQueueRequest
+	(*fieldmaskpb.FieldMask)(nil),     // 10: google.protobuf.FieldMask
+	(QueueStatus)(0),                  // 11: proto.QueueStatus
+	(*KokaqNamespaceResponse)(nil),    // 12: proto.KokaqNamespaceResponse
+	(*StatusResponse)(nil),            // 13: proto.StatusResponse
+	(*KokaqQueueResponse)(nil),        // 14: proto.KokaqQueueResponse
+	(*KokaqSubscriptionResponse)(nil), // 15: proto.KokaqSubscriptionResponse
+	(*KokaqNamespaceRequest)(nil),     // 16: proto.KokaqNamespaceRequest
+	(*KokaqTopicRequest)(nil),         // 17: proto.KokaqTopicRequest
+	(*KokaqSubscriptionRequest)(nil),  // 18: proto.KokaqSubscriptionRequest
+	(*WatchStatsRequest)(nil),         // 19: proto.WatchStatsRequest
+	(*KokaqTopicResponse)(nil),        // 20: proto.KokaqTopicResponse
+	(*KokaqStatsResponse)(nil),        // 21: proto.KokaqStatsResponse
 }
 var file_proto_control_proto_depIdxs = []int32{
-	0, // 0: proto.KokaqControlPlane.GetDataplane:input_type -> proto.GetDataplaneRequest
-	2, // 1: proto.KokaqControlPlane.GetNamespace:input_type -> proto.KokaqNamespaceRequest
-	2, // 2: proto.KokaqControlPlane.AddNamespace:input_type -> proto.KokaqNamespaceRequest
-	2, // 3: proto.KokaqControlPlane.DeleteNamespace:input_type -> proto.KokaqNamespaceRequest
-	3, // 4: proto.KokaqControlPlane.AddQueue:input_type -> proto.KokaqQueueRequest
-	3, // 5: proto.KokaqControlPlane.GetQueue:input_type -> proto.KokaqQueueRequest
-	3, // 6: proto.KokaqControlPlane.DeleteQueue:input_type -> proto.KokaqQueueRequest
-	3, // 7: proto.KokaqControlPlane.ClearQueue:input_type -> proto.KokaqQueueRequest
-	2, // 8: proto.KokaqControlPlane.GetStats:input_type -> proto.KokaqNamespaceRequest
-	1, // 9: proto.KokaqControlPlane.GetDataplane:output_type -> proto.GetDataplaneResponse
-	4, // 10: proto.KokaqControlPlane.GetNamespace:output_type -> proto.KokaqNamespaceResponse
-	4, // 11: proto.KokaqControlPlane.AddNamespace:output_type -> proto.KokaqNamespaceResponse
-	5, // 12: proto.KokaqControlPlane.DeleteNamespace:output_type -> proto.StatusResponse
-	6, // 13: proto.KokaqControlPlane.AddQueue:output_type -> proto.KokaqQueueResponse
-	6, // 14: proto.KokaqControlPlane.GetQueue:output_type -> proto.KokaqQueueResponse
-	5, // 15: proto.KokaqControlPlane.DeleteQueue:output_type -> proto.StatusResponse
-	5, // 16: proto.KokaqControlPlane.ClearQueue:output_type -> proto.StatusResponse
-	7, // 17: proto.KokaqControlPlane.GetStats:output_type -> proto.KokaqStatsResponse
-	9, // [9:18] is the sub-list for method output_type
-	0, // [0:9] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	9,  // 0: proto.UpdateQueueRequest.queue:type_name -> proto.KokaqQueueRequest
+	10, // 1: proto.UpdateQueueRequest.update_mask:type_name -> google.protobuf.FieldMask
+	11, // 2: proto.SetQueueStatusRequest.status:type_name -> proto.QueueStatus
+	12, // 3: proto.ListNamespacesResponse.namespaces:type_name -> proto.KokaqNamespaceResponse
+	13, // 4: proto.ListNamespacesResponse.status:type_name -> proto.StatusResponse
+	14, // 5: proto.ListQueuesResponse.queues:type_name -> proto.KokaqQueueResponse
+	13, // 6: proto.ListQueuesResponse.status:type_name -> proto.StatusResponse
+	15, // 7: proto.ListSubscriptionsResponse.subscriptions:type_name -> proto.KokaqSubscriptionResponse
+	13, // 8: proto.ListSubscriptionsResponse.status:type_name -> proto.StatusResponse
+	0,  // 9: proto.KokaqControlPlane.GetDataplane:input_type -> proto.GetDataplaneRequest
+	16, // 10: proto.KokaqControlPlane.GetNamespace:input_type -> proto.KokaqNamespaceRequest
+	16, // 11: proto.KokaqControlPlane.AddNamespace:input_type -> proto.KokaqNamespaceRequest
+	16, // 12: proto.KokaqControlPlane.DeleteNamespace:input_type -> proto.KokaqNamespaceRequest
+	4,  // 13: proto.KokaqControlPlane.ListNamespaces:input_type -> proto.ListNamespacesRequest
+	9,  // 14: proto.KokaqControlPlane.AddQueue:input_type -> proto.KokaqQueueRequest
+	9,  // 15: proto.KokaqControlPlane.GetQueue:input_type -> proto.KokaqQueueRequest
+	2,  // 16: proto.KokaqControlPlane.UpdateQueue:input_type -> proto.UpdateQueueRequest
+	3,  // 17: proto.KokaqControlPlane.SetQueueStatus:input_type -> proto.SetQueueStatusRequest
+	9,  // 18: proto.KokaqControlPlane.DeleteQueue:input_type -> proto.KokaqQueueRequest
+	6,  // 19: proto.KokaqControlPlane.ListQueues:input_type -> proto.ListQueuesRequest
+	9,  // 20: proto.KokaqControlPlane.ClearQueue:input_type -> proto.KokaqQueueRequest
+	17, // 21: proto.KokaqControlPlane.AddTopic:input_type -> proto.KokaqTopicRequest
+	17, // 22: proto.KokaqControlPlane.GetTopic:input_type -> proto.KokaqTopicRequest
+	17, // 23: proto.KokaqControlPlane.DeleteTopic:input_type -> proto.KokaqTopicRequest
+	18, // 24: proto.KokaqControlPlane.AddSubscription:input_type -> proto.KokaqSubscriptionRequest
+	18, // 25: proto.KokaqControlPlane.DeleteSubscription:input_type -> proto.KokaqSubscriptionRequest
+	17, // 26: proto.KokaqControlPlane.ListSubscriptions:input_type -> proto.KokaqTopicRequest
+	16, // 27: proto.KokaqControlPlane.GetStats:input_type -> proto.KokaqNamespaceRequest
+	19, // 28: proto.KokaqControlPlane.WatchStats:input_type -> proto.WatchStatsRequest
+	1,  // 29: proto.KokaqControlPlane.GetDataplane:output_type -> proto.GetDataplaneResponse
+	12, // 30: proto.KokaqControlPlane.GetNamespace:output_type -> proto.KokaqNamespaceResponse
+	12, // 31: proto.KokaqControlPlane.AddNamespace:output_type -> proto.KokaqNamespaceResponse
+	13, // 32: proto.KokaqControlPlane.DeleteNamespace:output_type -> proto.StatusResponse
+	5,  // 33: proto.KokaqControlPlane.ListNamespaces:output_type -> proto.ListNamespacesResponse
+	14, // 34: proto.KokaqControlPlane.AddQueue:output_type -> proto.KokaqQueueResponse
+	14, // 35: proto.KokaqControlPlane.GetQueue:output_type -> proto.KokaqQueueResponse
+	14, // 36: proto.KokaqControlPlane.UpdateQueue:output_type -> proto.KokaqQueueResponse
+	14, // 37: proto.KokaqControlPlane.SetQueueStatus:output_type -> proto.KokaqQueueResponse
+	13, // 38: proto.KokaqControlPlane.DeleteQueue:output_type -> proto.StatusResponse
+	7,  // 39: proto.KokaqControlPlane.ListQueues:output_type -> proto.ListQueuesResponse
+	13, // 40: proto.KokaqControlPlane.ClearQueue:output_type -> proto.StatusResponse
+	20, // 41: proto.KokaqControlPlane.AddTopic:output_type -> proto.KokaqTopicResponse
+	20, // 42: proto.KokaqControlPlane.GetTopic:output_type -> proto.KokaqTopicResponse
+	13, // 43: proto.KokaqControlPlane.DeleteTopic:output_type -> proto.StatusResponse
+	15, // 44: proto.KokaqControlPlane.AddSubscription:output_type -> proto.KokaqSubscriptionResponse
+	13, // 45: proto.KokaqControlPlane.DeleteSubscription:output_type -> proto.StatusResponse
+	8,  // 46: proto.KokaqControlPlane.ListSubscriptions:output_type -> proto.ListSubscriptionsResponse
+	21, // 47: proto.KokaqControlPlane.GetStats:output_type -> proto.KokaqStatsResponse
+	21, // 48: proto.KokaqControlPlane.WatchStats:output_type -> proto.KokaqStatsResponse
+	29, // [29:49] is the sub-list for method output_type
+	9,  // [9:29] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_proto_control_proto_init() }
@@ -218,7 +754,7 @@ func file_proto_control_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_control_proto_rawDesc), len(file_proto_control_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
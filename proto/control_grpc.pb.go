@@ -19,15 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	KokaqControlPlane_GetDataplane_FullMethodName    = "/proto.KokaqControlPlane/GetDataplane"
-	KokaqControlPlane_GetNamespace_FullMethodName    = "/proto.KokaqControlPlane/GetNamespace"
-	KokaqControlPlane_AddNamespace_FullMethodName    = "/proto.KokaqControlPlane/AddNamespace"
-	KokaqControlPlane_DeleteNamespace_FullMethodName = "/proto.KokaqControlPlane/DeleteNamespace"
-	KokaqControlPlane_AddQueue_FullMethodName        = "/proto.KokaqControlPlane/AddQueue"
-	KokaqControlPlane_GetQueue_FullMethodName        = "/proto.KokaqControlPlane/GetQueue"
-	KokaqControlPlane_DeleteQueue_FullMethodName     = "/proto.KokaqControlPlane/DeleteQueue"
-	KokaqControlPlane_ClearQueue_FullMethodName      = "/proto.KokaqControlPlane/ClearQueue"
-	KokaqControlPlane_GetStats_FullMethodName        = "/proto.KokaqControlPlane/GetStats"
+	KokaqControlPlane_GetDataplane_FullMethodName       = "/proto.KokaqControlPlane/GetDataplane"
+	KokaqControlPlane_GetNamespace_FullMethodName       = "/proto.KokaqControlPlane/GetNamespace"
+	KokaqControlPlane_AddNamespace_FullMethodName       = "/proto.KokaqControlPlane/AddNamespace"
+	KokaqControlPlane_DeleteNamespace_FullMethodName    = "/proto.KokaqControlPlane/DeleteNamespace"
+	KokaqControlPlane_ListNamespaces_FullMethodName     = "/proto.KokaqControlPlane/ListNamespaces"
+	KokaqControlPlane_AddQueue_FullMethodName           = "/proto.KokaqControlPlane/AddQueue"
+	KokaqControlPlane_GetQueue_FullMethodName           = "/proto.KokaqControlPlane/GetQueue"
+	KokaqControlPlane_UpdateQueue_FullMethodName        = "/proto.KokaqControlPlane/UpdateQueue"
+	KokaqControlPlane_SetQueueStatus_FullMethodName     = "/proto.KokaqControlPlane/SetQueueStatus"
+	KokaqControlPlane_DeleteQueue_FullMethodName        = "/proto.KokaqControlPlane/DeleteQueue"
+	KokaqControlPlane_ListQueues_FullMethodName         = "/proto.KokaqControlPlane/ListQueues"
+	KokaqControlPlane_ClearQueue_FullMethodName         = "/proto.KokaqControlPlane/ClearQueue"
+	KokaqControlPlane_AddTopic_FullMethodName           = "/proto.KokaqControlPlane/AddTopic"
+	KokaqControlPlane_GetTopic_FullMethodName           = "/proto.KokaqControlPlane/GetTopic"
+	KokaqControlPlane_DeleteTopic_FullMethodName        = "/proto.KokaqControlPlane/DeleteTopic"
+	KokaqControlPlane_AddSubscription_FullMethodName    = "/proto.KokaqControlPlane/AddSubscription"
+	KokaqControlPlane_DeleteSubscription_FullMethodName = "/proto.KokaqControlPlane/DeleteSubscription"
+	KokaqControlPlane_ListSubscriptions_FullMethodName  = "/proto.KokaqControlPlane/ListSubscriptions"
+	KokaqControlPlane_GetStats_FullMethodName           = "/proto.KokaqControlPlane/GetStats"
+	KokaqControlPlane_WatchStats_FullMethodName         = "/proto.KokaqControlPlane/WatchStats"
 )
 
 // KokaqControlPlaneClient is the client API for KokaqControlPlane service.
@@ -40,11 +51,22 @@ type KokaqControlPlaneClient interface {
 	GetNamespace(ctx context.Context, in *KokaqNamespaceRequest, opts ...grpc.CallOption) (*KokaqNamespaceResponse, error)
 	AddNamespace(ctx context.Context, in *KokaqNamespaceRequest, opts ...grpc.CallOption) (*KokaqNamespaceResponse, error)
 	DeleteNamespace(ctx context.Context, in *KokaqNamespaceRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
 	AddQueue(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*KokaqQueueResponse, error)
 	GetQueue(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*KokaqQueueResponse, error)
+	UpdateQueue(ctx context.Context, in *UpdateQueueRequest, opts ...grpc.CallOption) (*KokaqQueueResponse, error)
+	SetQueueStatus(ctx context.Context, in *SetQueueStatusRequest, opts ...grpc.CallOption) (*KokaqQueueResponse, error)
 	DeleteQueue(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListQueues(ctx context.Context, in *ListQueuesRequest, opts ...grpc.CallOption) (*ListQueuesResponse, error)
 	ClearQueue(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	AddTopic(ctx context.Context, in *KokaqTopicRequest, opts ...grpc.CallOption) (*KokaqTopicResponse, error)
+	GetTopic(ctx context.Context, in *KokaqTopicRequest, opts ...grpc.CallOption) (*KokaqTopicResponse, error)
+	DeleteTopic(ctx context.Context, in *KokaqTopicRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	AddSubscription(ctx context.Context, in *KokaqSubscriptionRequest, opts ...grpc.CallOption) (*KokaqSubscriptionResponse, error)
+	DeleteSubscription(ctx context.Context, in *KokaqSubscriptionRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListSubscriptions(ctx context.Context, in *KokaqTopicRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
 	GetStats(ctx context.Context, in *KokaqNamespaceRequest, opts ...grpc.CallOption) (*KokaqStatsResponse, error)
+	WatchStats(ctx context.Context, in *WatchStatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KokaqStatsResponse], error)
 }
 
 type kokaqControlPlaneClient struct {
@@ -95,6 +117,16 @@ func (c *kokaqControlPlaneClient) DeleteNamespace(ctx context.Context, in *Kokaq
 	return out, nil
 }
 
+func (c *kokaqControlPlaneClient) ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNamespacesResponse)
+	err := c.cc.Invoke(ctx, KokaqControlPlane_ListNamespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kokaqControlPlaneClient) AddQueue(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*KokaqQueueResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(KokaqQueueResponse)
@@ -115,6 +147,26 @@ func (c *kokaqControlPlaneClient) GetQueue(ctx context.Context, in *KokaqQueueRe
 	return out, nil
 }
 
+func (c *kokaqControlPlaneClient) UpdateQueue(ctx context.Context, in *UpdateQueueRequest, opts ...grpc.CallOption) (*KokaqQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KokaqQueueResponse)
+	err := c.cc.Invoke(ctx, KokaqControlPlane_UpdateQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqControlPlaneClient) SetQueueStatus(ctx context.Context, in *SetQueueStatusRequest, opts ...grpc.CallOption) (*KokaqQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KokaqQueueResponse)
+	err := c.cc.Invoke(ctx, KokaqControlPlane_SetQueueStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kokaqControlPlaneClient) DeleteQueue(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StatusResponse)
@@ -125,6 +177,16 @@ func (c *kokaqControlPlaneClient) DeleteQueue(ctx context.Context, in *KokaqQueu
 	return out, nil
 }
 
+func (c *kokaqControlPlaneClient) ListQueues(ctx context.Context, in *ListQueuesRequest, opts ...grpc.CallOption) (*ListQueuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueuesResponse)
+	err := c.cc.Invoke(ctx, KokaqControlPlane_ListQueues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kokaqControlPlaneClient) ClearQueue(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StatusResponse)
@@ -135,6 +197,66 @@ func (c *kokaqControlPlaneClient) ClearQueue(ctx context.Context, in *KokaqQueue
 	return out, nil
 }
 
+func (c *kokaqControlPlaneClient) AddTopic(ctx context.Context, in *KokaqTopicRequest, opts ...grpc.CallOption) (*KokaqTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KokaqTopicResponse)
+	err := c.cc.Invoke(ctx, KokaqControlPlane_AddTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqControlPlaneClient) GetTopic(ctx context.Context, in *KokaqTopicRequest, opts ...grpc.CallOption) (*KokaqTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KokaqTopicResponse)
+	err := c.cc.Invoke(ctx, KokaqControlPlane_GetTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqControlPlaneClient) DeleteTopic(ctx context.Context, in *KokaqTopicRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, KokaqControlPlane_DeleteTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqControlPlaneClient) AddSubscription(ctx context.Context, in *KokaqSubscriptionRequest, opts ...grpc.CallOption) (*KokaqSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KokaqSubscriptionResponse)
+	err := c.cc.Invoke(ctx, KokaqControlPlane_AddSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqControlPlaneClient) DeleteSubscription(ctx context.Context, in *KokaqSubscriptionRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, KokaqControlPlane_DeleteSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqControlPlaneClient) ListSubscriptions(ctx context.Context, in *KokaqTopicRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, KokaqControlPlane_ListSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kokaqControlPlaneClient) GetStats(ctx context.Context, in *KokaqNamespaceRequest, opts ...grpc.CallOption) (*KokaqStatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(KokaqStatsResponse)
@@ -145,6 +267,25 @@ func (c *kokaqControlPlaneClient) GetStats(ctx context.Context, in *KokaqNamespa
 	return out, nil
 }
 
+func (c *kokaqControlPlaneClient) WatchStats(ctx context.Context, in *WatchStatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KokaqStatsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &KokaqControlPlane_ServiceDesc.Streams[0], KokaqControlPlane_WatchStats_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchStatsRequest, KokaqStatsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KokaqControlPlane_WatchStatsClient = grpc.ServerStreamingClient[KokaqStatsResponse]
+
 // KokaqControlPlaneServer is the server API for KokaqControlPlane service.
 // All implementations must embed UnimplementedKokaqControlPlaneServer
 // for forward compatibility.
@@ -155,11 +296,22 @@ type KokaqControlPlaneServer interface {
 	GetNamespace(context.Context, *KokaqNamespaceRequest) (*KokaqNamespaceResponse, error)
 	AddNamespace(context.Context, *KokaqNamespaceRequest) (*KokaqNamespaceResponse, error)
 	DeleteNamespace(context.Context, *KokaqNamespaceRequest) (*StatusResponse, error)
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
 	AddQueue(context.Context, *KokaqQueueRequest) (*KokaqQueueResponse, error)
 	GetQueue(context.Context, *KokaqQueueRequest) (*KokaqQueueResponse, error)
+	UpdateQueue(context.Context, *UpdateQueueRequest) (*KokaqQueueResponse, error)
+	SetQueueStatus(context.Context, *SetQueueStatusRequest) (*KokaqQueueResponse, error)
 	DeleteQueue(context.Context, *KokaqQueueRequest) (*StatusResponse, error)
+	ListQueues(context.Context, *ListQueuesRequest) (*ListQueuesResponse, error)
 	ClearQueue(context.Context, *KokaqQueueRequest) (*StatusResponse, error)
+	AddTopic(context.Context, *KokaqTopicRequest) (*KokaqTopicResponse, error)
+	GetTopic(context.Context, *KokaqTopicRequest) (*KokaqTopicResponse, error)
+	DeleteTopic(context.Context, *KokaqTopicRequest) (*StatusResponse, error)
+	AddSubscription(context.Context, *KokaqSubscriptionRequest) (*KokaqSubscriptionResponse, error)
+	DeleteSubscription(context.Context, *KokaqSubscriptionRequest) (*StatusResponse, error)
+	ListSubscriptions(context.Context, *KokaqTopicRequest) (*ListSubscriptionsResponse, error)
 	GetStats(context.Context, *KokaqNamespaceRequest) (*KokaqStatsResponse, error)
+	WatchStats(*WatchStatsRequest, grpc.ServerStreamingServer[KokaqStatsResponse]) error
 	mustEmbedUnimplementedKokaqControlPlaneServer()
 }
 
@@ -182,21 +334,54 @@ func (UnimplementedKokaqControlPlaneServer) AddNamespace(context.Context, *Kokaq
 func (UnimplementedKokaqControlPlaneServer) DeleteNamespace(context.Context, *KokaqNamespaceRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
 }
+func (UnimplementedKokaqControlPlaneServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaces not implemented")
+}
 func (UnimplementedKokaqControlPlaneServer) AddQueue(context.Context, *KokaqQueueRequest) (*KokaqQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddQueue not implemented")
 }
 func (UnimplementedKokaqControlPlaneServer) GetQueue(context.Context, *KokaqQueueRequest) (*KokaqQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQueue not implemented")
 }
+func (UnimplementedKokaqControlPlaneServer) UpdateQueue(context.Context, *UpdateQueueRequest) (*KokaqQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+}
+func (UnimplementedKokaqControlPlaneServer) SetQueueStatus(context.Context, *SetQueueStatusRequest) (*KokaqQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQueueStatus not implemented")
+}
 func (UnimplementedKokaqControlPlaneServer) DeleteQueue(context.Context, *KokaqQueueRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
 }
+func (UnimplementedKokaqControlPlaneServer) ListQueues(context.Context, *ListQueuesRequest) (*ListQueuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueues not implemented")
+}
 func (UnimplementedKokaqControlPlaneServer) ClearQueue(context.Context, *KokaqQueueRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearQueue not implemented")
 }
+func (UnimplementedKokaqControlPlaneServer) AddTopic(context.Context, *KokaqTopicRequest) (*KokaqTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTopic not implemented")
+}
+func (UnimplementedKokaqControlPlaneServer) GetTopic(context.Context, *KokaqTopicRequest) (*KokaqTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
+}
+func (UnimplementedKokaqControlPlaneServer) DeleteTopic(context.Context, *KokaqTopicRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTopic not implemented")
+}
+func (UnimplementedKokaqControlPlaneServer) AddSubscription(context.Context, *KokaqSubscriptionRequest) (*KokaqSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubscription not implemented")
+}
+func (UnimplementedKokaqControlPlaneServer) DeleteSubscription(context.Context, *KokaqSubscriptionRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
+}
+func (UnimplementedKokaqControlPlaneServer) ListSubscriptions(context.Context, *KokaqTopicRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
 func (UnimplementedKokaqControlPlaneServer) GetStats(context.Context, *KokaqNamespaceRequest) (*KokaqStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
 }
+func (UnimplementedKokaqControlPlaneServer) WatchStats(*WatchStatsRequest, grpc.ServerStreamingServer[KokaqStatsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStats not implemented")
+}
 func (UnimplementedKokaqControlPlaneServer) mustEmbedUnimplementedKokaqControlPlaneServer() {}
 func (UnimplementedKokaqControlPlaneServer) testEmbeddedByValue()                           {}
 
@@ -290,6 +475,24 @@ func _KokaqControlPlane_DeleteNamespace_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KokaqControlPlane_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqControlPlaneServer).ListNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqControlPlane_ListNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqControlPlaneServer).ListNamespaces(ctx, req.(*ListNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KokaqControlPlane_AddQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KokaqQueueRequest)
 	if err := dec(in); err != nil {
@@ -326,6 +529,42 @@ func _KokaqControlPlane_GetQueue_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KokaqControlPlane_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqControlPlaneServer).UpdateQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqControlPlane_UpdateQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqControlPlaneServer).UpdateQueue(ctx, req.(*UpdateQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqControlPlane_SetQueueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQueueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqControlPlaneServer).SetQueueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqControlPlane_SetQueueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqControlPlaneServer).SetQueueStatus(ctx, req.(*SetQueueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KokaqControlPlane_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KokaqQueueRequest)
 	if err := dec(in); err != nil {
@@ -344,6 +583,24 @@ func _KokaqControlPlane_DeleteQueue_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KokaqControlPlane_ListQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqControlPlaneServer).ListQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqControlPlane_ListQueues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqControlPlaneServer).ListQueues(ctx, req.(*ListQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KokaqControlPlane_ClearQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KokaqQueueRequest)
 	if err := dec(in); err != nil {
@@ -362,6 +619,114 @@ func _KokaqControlPlane_ClearQueue_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KokaqControlPlane_AddTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KokaqTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqControlPlaneServer).AddTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqControlPlane_AddTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqControlPlaneServer).AddTopic(ctx, req.(*KokaqTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqControlPlane_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KokaqTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqControlPlaneServer).GetTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqControlPlane_GetTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqControlPlaneServer).GetTopic(ctx, req.(*KokaqTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqControlPlane_DeleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KokaqTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqControlPlaneServer).DeleteTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqControlPlane_DeleteTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqControlPlaneServer).DeleteTopic(ctx, req.(*KokaqTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqControlPlane_AddSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KokaqSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqControlPlaneServer).AddSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqControlPlane_AddSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqControlPlaneServer).AddSubscription(ctx, req.(*KokaqSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqControlPlane_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KokaqSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqControlPlaneServer).DeleteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqControlPlane_DeleteSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqControlPlaneServer).DeleteSubscription(ctx, req.(*KokaqSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqControlPlane_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KokaqTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqControlPlaneServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqControlPlane_ListSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqControlPlaneServer).ListSubscriptions(ctx, req.(*KokaqTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KokaqControlPlane_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KokaqNamespaceRequest)
 	if err := dec(in); err != nil {
@@ -380,6 +745,17 @@ func _KokaqControlPlane_GetStats_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KokaqControlPlane_WatchStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KokaqControlPlaneServer).WatchStats(m, &grpc.GenericServerStream[WatchStatsRequest, KokaqStatsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KokaqControlPlane_WatchStatsServer = grpc.ServerStreamingServer[KokaqStatsResponse]
+
 // KokaqControlPlane_ServiceDesc is the grpc.ServiceDesc for KokaqControlPlane service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -403,6 +779,10 @@ var KokaqControlPlane_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNamespace",
 			Handler:    _KokaqControlPlane_DeleteNamespace_Handler,
 		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _KokaqControlPlane_ListNamespaces_Handler,
+		},
 		{
 			MethodName: "AddQueue",
 			Handler:    _KokaqControlPlane_AddQueue_Handler,
@@ -411,19 +791,61 @@ var KokaqControlPlane_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetQueue",
 			Handler:    _KokaqControlPlane_GetQueue_Handler,
 		},
+		{
+			MethodName: "UpdateQueue",
+			Handler:    _KokaqControlPlane_UpdateQueue_Handler,
+		},
+		{
+			MethodName: "SetQueueStatus",
+			Handler:    _KokaqControlPlane_SetQueueStatus_Handler,
+		},
 		{
 			MethodName: "DeleteQueue",
 			Handler:    _KokaqControlPlane_DeleteQueue_Handler,
 		},
+		{
+			MethodName: "ListQueues",
+			Handler:    _KokaqControlPlane_ListQueues_Handler,
+		},
 		{
 			MethodName: "ClearQueue",
 			Handler:    _KokaqControlPlane_ClearQueue_Handler,
 		},
+		{
+			MethodName: "AddTopic",
+			Handler:    _KokaqControlPlane_AddTopic_Handler,
+		},
+		{
+			MethodName: "GetTopic",
+			Handler:    _KokaqControlPlane_GetTopic_Handler,
+		},
+		{
+			MethodName: "DeleteTopic",
+			Handler:    _KokaqControlPlane_DeleteTopic_Handler,
+		},
+		{
+			MethodName: "AddSubscription",
+			Handler:    _KokaqControlPlane_AddSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteSubscription",
+			Handler:    _KokaqControlPlane_DeleteSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _KokaqControlPlane_ListSubscriptions_Handler,
+		},
 		{
 			MethodName: "GetStats",
 			Handler:    _KokaqControlPlane_GetStats_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStats",
+			Handler:       _KokaqControlPlane_WatchStats_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/control.proto",
 }
@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,19 +23,285 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PrioritySelection int32
+
+const (
+	PrioritySelection_PRIORITY_SELECTION_STRICT        PrioritySelection = 0 // Always take the highest priority available
+	PrioritySelection_PRIORITY_SELECTION_WEIGHTED_FAIR PrioritySelection = 1 // Share deliveries between priorities by weight
+)
+
+// Enum value maps for PrioritySelection.
+var (
+	PrioritySelection_name = map[int32]string{
+		0: "PRIORITY_SELECTION_STRICT",
+		1: "PRIORITY_SELECTION_WEIGHTED_FAIR",
+	}
+	PrioritySelection_value = map[string]int32{
+		"PRIORITY_SELECTION_STRICT":        0,
+		"PRIORITY_SELECTION_WEIGHTED_FAIR": 1,
+	}
+)
+
+func (x PrioritySelection) Enum() *PrioritySelection {
+	p := new(PrioritySelection)
+	*p = x
+	return p
+}
+
+func (x PrioritySelection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrioritySelection) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_proto_enumTypes[0].Descriptor()
+}
+
+func (PrioritySelection) Type() protoreflect.EnumType {
+	return &file_proto_data_proto_enumTypes[0]
+}
+
+func (x PrioritySelection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrioritySelection.Descriptor instead.
+func (PrioritySelection) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{0}
+}
+
+type MessageState int32
+
+const (
+	MessageState_MESSAGE_STATE_UNSPECIFIED   MessageState = 0 // Default/unset
+	MessageState_MESSAGE_STATE_VISIBLE       MessageState = 1 // Available for delivery
+	MessageState_MESSAGE_STATE_LOCKED        MessageState = 2 // Held by a consumer lock
+	MessageState_MESSAGE_STATE_SCHEDULED     MessageState = 3 // Waiting out a redelivery delay
+	MessageState_MESSAGE_STATE_DEAD_LETTERED MessageState = 4 // Moved to the dead-letter queue
+)
+
+// Enum value maps for MessageState.
+var (
+	MessageState_name = map[int32]string{
+		0: "MESSAGE_STATE_UNSPECIFIED",
+		1: "MESSAGE_STATE_VISIBLE",
+		2: "MESSAGE_STATE_LOCKED",
+		3: "MESSAGE_STATE_SCHEDULED",
+		4: "MESSAGE_STATE_DEAD_LETTERED",
+	}
+	MessageState_value = map[string]int32{
+		"MESSAGE_STATE_UNSPECIFIED":   0,
+		"MESSAGE_STATE_VISIBLE":       1,
+		"MESSAGE_STATE_LOCKED":        2,
+		"MESSAGE_STATE_SCHEDULED":     3,
+		"MESSAGE_STATE_DEAD_LETTERED": 4,
+	}
+)
+
+func (x MessageState) Enum() *MessageState {
+	p := new(MessageState)
+	*p = x
+	return p
+}
+
+func (x MessageState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_proto_enumTypes[1].Descriptor()
+}
+
+func (MessageState) Type() protoreflect.EnumType {
+	return &file_proto_data_proto_enumTypes[1]
+}
+
+func (x MessageState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageState.Descriptor instead.
+func (MessageState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{1}
+}
+
+type MessageOrder int32
+
+const (
+	MessageOrder_MESSAGE_ORDER_PRIORITY     MessageOrder = 0 // Delivery order: highest priority first, then enqueue order
+	MessageOrder_MESSAGE_ORDER_ENQUEUE_TIME MessageOrder = 1 // Enqueue order regardless of priority
+)
+
+// Enum value maps for MessageOrder.
+var (
+	MessageOrder_name = map[int32]string{
+		0: "MESSAGE_ORDER_PRIORITY",
+		1: "MESSAGE_ORDER_ENQUEUE_TIME",
+	}
+	MessageOrder_value = map[string]int32{
+		"MESSAGE_ORDER_PRIORITY":     0,
+		"MESSAGE_ORDER_ENQUEUE_TIME": 1,
+	}
+)
+
+func (x MessageOrder) Enum() *MessageOrder {
+	p := new(MessageOrder)
+	*p = x
+	return p
+}
+
+func (x MessageOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_data_proto_enumTypes[2].Descriptor()
+}
+
+func (MessageOrder) Type() protoreflect.EnumType {
+	return &file_proto_data_proto_enumTypes[2]
+}
+
+func (x MessageOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageOrder.Descriptor instead.
+func (MessageOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{2}
+}
+
+// Inclusive bounds on the priorities a Dequeue or PeekLock takes from
+type PriorityRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *uint64                `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *uint64                `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriorityRange) Reset() {
+	*x = PriorityRange{}
+	mi := &file_proto_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriorityRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityRange) ProtoMessage() {}
+
+func (x *PriorityRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityRange.ProtoReflect.Descriptor instead.
+func (*PriorityRange) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PriorityRange) GetMin() uint64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *PriorityRange) GetMax() uint64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// Claim check: locates a payload kept in a blob store instead of inline
+type PayloadReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`       // at most 215 bytes, the most a TCP metadata field holds
+	Size          uint64                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`    // in bytes
+	Sha256        string                 `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"` // hex encoded hash of the payload
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayloadReference) Reset() {
+	*x = PayloadReference{}
+	mi := &file_proto_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayloadReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayloadReference) ProtoMessage() {}
+
+func (x *PayloadReference) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayloadReference.ProtoReflect.Descriptor instead.
+func (*PayloadReference) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayloadReference) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PayloadReference) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PayloadReference) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
 type KokaqMessageHeaders struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	CorrelationId string                 `protobuf:"bytes,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
 	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	FailureReason FailureReason          `protobuf:"varint,4,opt,name=failure_reason,json=failureReason,proto3,enum=proto.FailureReason" json:"failure_reason,omitempty"`
+	// Application defined properties, carried unchanged through Enqueue,
+	// Dequeue, Peek and the dead-letter queue. A key and its value may take at
+	// most 253 bytes together, the most a TCP property field holds.
+	Properties    map[string]string `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	HopCount      uint32            `protobuf:"varint,6,opt,name=hop_count,json=hopCount,proto3" json:"hop_count,omitempty"` // times the message was auto-forwarded between queues
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KokaqMessageHeaders) Reset() {
 	*x = KokaqMessageHeaders{}
-	mi := &file_proto_data_proto_msgTypes[0]
+	mi := &file_proto_data_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46,7 +313,7 @@ func (x *KokaqMessageHeaders) String() string {
 func (*KokaqMessageHeaders) ProtoMessage() {}
 
 func (x *KokaqMessageHeaders) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[0]
+	mi := &file_proto_data_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,7 +326,7 @@ func (x *KokaqMessageHeaders) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KokaqMessageHeaders.ProtoReflect.Descriptor instead.
 func (*KokaqMessageHeaders) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{0}
+	return file_proto_data_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *KokaqMessageHeaders) GetContentType() string {
@@ -90,21 +357,48 @@ func (x *KokaqMessageHeaders) GetFailureReason() FailureReason {
 	return FailureReason_MESSAGE_FAILURE_UNSPECIFIED
 }
 
+func (x *KokaqMessageHeaders) GetProperties() map[string]string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *KokaqMessageHeaders) GetHopCount() uint32 {
+	if x != nil {
+		return x.HopCount
+	}
+	return 0
+}
+
 type KokaqMessageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Queue         string                 `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Priority      uint64                 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
-	Payload       []byte                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
-	Headers       *KokaqMessageHeaders   `protobuf:"bytes,6,opt,name=headers,proto3" json:"headers,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MessageId string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue     string                 `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Priority  uint64                 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Payload   []byte                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Headers   *KokaqMessageHeaders   `protobuf:"bytes,6,opt,name=headers,proto3" json:"headers,omitempty"`
+	// Overrides the queue default_time_to_live. Once elapsed the message is
+	// dead-lettered with FailureReason.EXPIRED, or dropped if dead-lettering is off.
+	TimeToLive *durationpb.Duration `protobuf:"bytes,7,opt,name=time_to_live,json=timeToLive,proto3" json:"time_to_live,omitempty"`
+	// Groups messages for ordered delivery. Messages sharing a session_id are
+	// delivered in enqueue order to the one consumer holding the session lock,
+	// and only under that lock: Dequeue and PeekLock without a session_lock_id
+	// skip every message carrying a session_id. Within a session enqueue order
+	// wins over priority; priority_range and priority_selection are ignored.
+	SessionId  string            `protobuf:"bytes,8,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PayloadRef *PayloadReference `protobuf:"bytes,9,opt,name=payload_ref,json=payloadRef,proto3" json:"payload_ref,omitempty"` // set instead of payload for claim-checked messages
+	// Enqueue to a topic instead of queue: a copy lands in the queue of every
+	// subscription whose filter matches. Copies keep message_id.
+	Topic         string `protobuf:"bytes,10,opt,name=topic,proto3" json:"topic,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *KokaqMessageRequest) Reset() {
 	*x = KokaqMessageRequest{}
-	mi := &file_proto_data_proto_msgTypes[1]
+	mi := &file_proto_data_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -116,7 +410,7 @@ func (x *KokaqMessageRequest) String() string {
 func (*KokaqMessageRequest) ProtoMessage() {}
 
 func (x *KokaqMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[1]
+	mi := &file_proto_data_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -129,7 +423,7 @@ func (x *KokaqMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KokaqMessageRequest.ProtoReflect.Descriptor instead.
 func (*KokaqMessageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{1}
+	return file_proto_data_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *KokaqMessageRequest) GetMessageId() string {
@@ -174,22 +468,53 @@ func (x *KokaqMessageRequest) GetHeaders() *KokaqMessageHeaders {
 	return nil
 }
 
+func (x *KokaqMessageRequest) GetTimeToLive() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToLive
+	}
+	return nil
+}
+
+func (x *KokaqMessageRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *KokaqMessageRequest) GetPayloadRef() *PayloadReference {
+	if x != nil {
+		return x.PayloadRef
+	}
+	return nil
+}
+
+func (x *KokaqMessageRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
 type KokaqMessageResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Message           *KokaqMessageRequest   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	CreatedOn         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
-	LastDequeued      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_dequeued,json=lastDequeued,proto3" json:"last_dequeued,omitempty"`
-	Expiry            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	DeadLetteredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dead_lettered_at,json=deadLetteredAt,proto3" json:"dead_lettered_at,omitempty"`
-	VisibilityTimeout uint32                 `protobuf:"varint,6,opt,name=visibility_timeout,json=visibilityTimeout,proto3" json:"visibility_timeout,omitempty"`
-	RetryCount        uint32                 `protobuf:"varint,7,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Message        *KokaqMessageRequest   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedOn      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
+	LastDequeued   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_dequeued,json=lastDequeued,proto3" json:"last_dequeued,omitempty"`
+	Expiry         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	DeadLetteredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dead_lettered_at,json=deadLetteredAt,proto3" json:"dead_lettered_at,omitempty"`
+	// Deprecated: Marked as deprecated in proto/data.proto.
+	VisibilityTimeout uint32               `protobuf:"varint,6,opt,name=visibility_timeout,json=visibilityTimeout,proto3" json:"visibility_timeout,omitempty"` // in seconds, use lock_timeout
+	RetryCount        uint32               `protobuf:"varint,7,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	LockTimeout       *durationpb.Duration `protobuf:"bytes,8,opt,name=lock_timeout,json=lockTimeout,proto3" json:"lock_timeout,omitempty"`
+	State             MessageState         `protobuf:"varint,9,opt,name=state,proto3,enum=proto.MessageState" json:"state,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *KokaqMessageResponse) Reset() {
 	*x = KokaqMessageResponse{}
-	mi := &file_proto_data_proto_msgTypes[2]
+	mi := &file_proto_data_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -201,7 +526,7 @@ func (x *KokaqMessageResponse) String() string {
 func (*KokaqMessageResponse) ProtoMessage() {}
 
 func (x *KokaqMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[2]
+	mi := &file_proto_data_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,7 +539,7 @@ func (x *KokaqMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KokaqMessageResponse.ProtoReflect.Descriptor instead.
 func (*KokaqMessageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{2}
+	return file_proto_data_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *KokaqMessageResponse) GetMessage() *KokaqMessageRequest {
@@ -252,6 +577,7 @@ func (x *KokaqMessageResponse) GetDeadLetteredAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/data.proto.
 func (x *KokaqMessageResponse) GetVisibilityTimeout() uint32 {
 	if x != nil {
 		return x.VisibilityTimeout
@@ -266,16 +592,31 @@ func (x *KokaqMessageResponse) GetRetryCount() uint32 {
 	return 0
 }
 
+func (x *KokaqMessageResponse) GetLockTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.LockTimeout
+	}
+	return nil
+}
+
+func (x *KokaqMessageResponse) GetState() MessageState {
+	if x != nil {
+		return x.State
+	}
+	return MessageState_MESSAGE_STATE_UNSPECIFIED
+}
+
 type EnqueueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       *KokaqMessageRequest   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	TxnId         string                 `protobuf:"bytes,2,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"` // stage in a transaction, the message is invisible until CommitTxn
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EnqueueRequest) Reset() {
 	*x = EnqueueRequest{}
-	mi := &file_proto_data_proto_msgTypes[3]
+	mi := &file_proto_data_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -287,7 +628,7 @@ func (x *EnqueueRequest) String() string {
 func (*EnqueueRequest) ProtoMessage() {}
 
 func (x *EnqueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[3]
+	mi := &file_proto_data_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +641,7 @@ func (x *EnqueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueRequest.ProtoReflect.Descriptor instead.
 func (*EnqueueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{3}
+	return file_proto_data_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EnqueueRequest) GetMessage() *KokaqMessageRequest {
@@ -310,17 +651,26 @@ func (x *EnqueueRequest) GetMessage() *KokaqMessageRequest {
 	return nil
 }
 
+func (x *EnqueueRequest) GetTxnId() string {
+	if x != nil {
+		return x.TxnId
+	}
+	return ""
+}
+
 type EnqueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 	EnqueuedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=enqueued_at,json=enqueuedAt,proto3" json:"enqueued_at,omitempty"`
+	IsDuplicate   bool                   `protobuf:"varint,3,opt,name=is_duplicate,json=isDuplicate,proto3" json:"is_duplicate,omitempty"` // message_id was seen within the duplicate detection window
+	Queues        []string               `protobuf:"bytes,4,rep,name=queues,proto3" json:"queues,omitempty"`                               // for a topic, the subscription queues that received a copy
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EnqueueResponse) Reset() {
 	*x = EnqueueResponse{}
-	mi := &file_proto_data_proto_msgTypes[4]
+	mi := &file_proto_data_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -332,7 +682,7 @@ func (x *EnqueueResponse) String() string {
 func (*EnqueueResponse) ProtoMessage() {}
 
 func (x *EnqueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[4]
+	mi := &file_proto_data_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,7 +695,7 @@ func (x *EnqueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueResponse.ProtoReflect.Descriptor instead.
 func (*EnqueueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{4}
+	return file_proto_data_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EnqueueResponse) GetMessageId() string {
@@ -362,18 +712,42 @@ func (x *EnqueueResponse) GetEnqueuedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *EnqueueResponse) GetIsDuplicate() bool {
+	if x != nil {
+		return x.IsDuplicate
+	}
+	return false
+}
+
+func (x *EnqueueResponse) GetQueues() []string {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
 type DequeueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
-	MaxCount      uint32                 `protobuf:"varint,3,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue     string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	MaxCount  uint32                 `protobuf:"varint,3,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	// Restricts delivery to the accepted session, in enqueue order. Without
+	// it only messages carrying no session_id are delivered.
+	SessionLockId     string            `protobuf:"bytes,4,opt,name=session_lock_id,json=sessionLockId,proto3" json:"session_lock_id,omitempty"`
+	Filter            string            `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"` // only deliver messages matching this expression, see package filter
+	PriorityRange     *PriorityRange    `protobuf:"bytes,6,opt,name=priority_range,json=priorityRange,proto3" json:"priority_range,omitempty"`
+	PrioritySelection PrioritySelection `protobuf:"varint,7,opt,name=priority_selection,json=prioritySelection,proto3,enum=proto.PrioritySelection" json:"priority_selection,omitempty"`
+	// Long poll: with no message available, hold the call until one arrives or
+	// wait_time passes, then return what is available, possibly nothing. The
+	// server never waits past the call deadline and may cap wait_time.
+	WaitTime      *durationpb.Duration `protobuf:"bytes,8,opt,name=wait_time,json=waitTime,proto3" json:"wait_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DequeueRequest) Reset() {
 	*x = DequeueRequest{}
-	mi := &file_proto_data_proto_msgTypes[5]
+	mi := &file_proto_data_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -385,7 +759,7 @@ func (x *DequeueRequest) String() string {
 func (*DequeueRequest) ProtoMessage() {}
 
 func (x *DequeueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[5]
+	mi := &file_proto_data_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +772,7 @@ func (x *DequeueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DequeueRequest.ProtoReflect.Descriptor instead.
 func (*DequeueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{5}
+	return file_proto_data_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DequeueRequest) GetNamespace() string {
@@ -422,18 +796,53 @@ func (x *DequeueRequest) GetMaxCount() uint32 {
 	return 0
 }
 
-type DequeueResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Messages      []*KokaqMessageResponse `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DequeueRequest) GetSessionLockId() string {
+	if x != nil {
+		return x.SessionLockId
+	}
+	return ""
 }
 
-func (x *DequeueResponse) Reset() {
-	*x = DequeueResponse{}
-	mi := &file_proto_data_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DequeueRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *DequeueRequest) GetPriorityRange() *PriorityRange {
+	if x != nil {
+		return x.PriorityRange
+	}
+	return nil
+}
+
+func (x *DequeueRequest) GetPrioritySelection() PrioritySelection {
+	if x != nil {
+		return x.PrioritySelection
+	}
+	return PrioritySelection_PRIORITY_SELECTION_STRICT
+}
+
+func (x *DequeueRequest) GetWaitTime() *durationpb.Duration {
+	if x != nil {
+		return x.WaitTime
+	}
+	return nil
+}
+
+type DequeueResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Messages      []*KokaqMessageResponse `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DequeueResponse) Reset() {
+	*x = DequeueResponse{}
+	mi := &file_proto_data_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DequeueResponse) String() string {
@@ -443,7 +852,7 @@ func (x *DequeueResponse) String() string {
 func (*DequeueResponse) ProtoMessage() {}
 
 func (x *DequeueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[6]
+	mi := &file_proto_data_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +865,7 @@ func (x *DequeueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DequeueResponse.ProtoReflect.Descriptor instead.
 func (*DequeueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{6}
+	return file_proto_data_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DequeueResponse) GetMessages() []*KokaqMessageResponse {
@@ -472,13 +881,14 @@ type PeekRequest struct {
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
 	Count         uint32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Filter        string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"` // only return messages matching this expression, see package filter
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PeekRequest) Reset() {
 	*x = PeekRequest{}
-	mi := &file_proto_data_proto_msgTypes[7]
+	mi := &file_proto_data_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -490,7 +900,7 @@ func (x *PeekRequest) String() string {
 func (*PeekRequest) ProtoMessage() {}
 
 func (x *PeekRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[7]
+	mi := &file_proto_data_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +913,7 @@ func (x *PeekRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeekRequest.ProtoReflect.Descriptor instead.
 func (*PeekRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{7}
+	return file_proto_data_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PeekRequest) GetNamespace() string {
@@ -527,6 +937,13 @@ func (x *PeekRequest) GetCount() uint32 {
 	return 0
 }
 
+func (x *PeekRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 type PeekResponse struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Messages      []*KokaqMessageResponse `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
@@ -536,7 +953,7 @@ type PeekResponse struct {
 
 func (x *PeekResponse) Reset() {
 	*x = PeekResponse{}
-	mi := &file_proto_data_proto_msgTypes[8]
+	mi := &file_proto_data_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -548,7 +965,7 @@ func (x *PeekResponse) String() string {
 func (*PeekResponse) ProtoMessage() {}
 
 func (x *PeekResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[8]
+	mi := &file_proto_data_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,7 +978,7 @@ func (x *PeekResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeekResponse.ProtoReflect.Descriptor instead.
 func (*PeekResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{8}
+	return file_proto_data_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PeekResponse) GetMessages() []*KokaqMessageResponse {
@@ -571,20 +988,32 @@ func (x *PeekResponse) GetMessages() []*KokaqMessageResponse {
 	return nil
 }
 
-// PeekLock - acquire locks on messages
+// PeekLock - acquire locks on messages. With message_id set only that message
+// is locked; otherwise the next max_count available messages are selected and
+// locked in one atomic step, so competing consumers never share a lock.
 type PeekLockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
-	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	LockDuration  uint32                 `protobuf:"varint,4,opt,name=lock_duration,json=lockDuration,proto3" json:"lock_duration,omitempty"` // in seconds
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue     string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	MessageId string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/data.proto.
+	LockDuration uint32 `protobuf:"varint,4,opt,name=lock_duration,json=lockDuration,proto3" json:"lock_duration,omitempty"` // in seconds, use lock_timeout
+	// Restricts locking to the accepted session, in enqueue order. Without it
+	// only messages carrying no session_id are locked.
+	SessionLockId     string               `protobuf:"bytes,5,opt,name=session_lock_id,json=sessionLockId,proto3" json:"session_lock_id,omitempty"`
+	PriorityRange     *PriorityRange       `protobuf:"bytes,6,opt,name=priority_range,json=priorityRange,proto3" json:"priority_range,omitempty"`
+	PrioritySelection PrioritySelection    `protobuf:"varint,7,opt,name=priority_selection,json=prioritySelection,proto3,enum=proto.PrioritySelection" json:"priority_selection,omitempty"`
+	MaxCount          uint32               `protobuf:"varint,8,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`          // ignored when message_id is set, 0 means 1
+	Filter            string               `protobuf:"bytes,9,opt,name=filter,proto3" json:"filter,omitempty"`                               // only lock messages matching this expression, see package filter
+	LockTimeout       *durationpb.Duration `protobuf:"bytes,10,opt,name=lock_timeout,json=lockTimeout,proto3" json:"lock_timeout,omitempty"` // wins over lock_duration when set
+	WaitTime          *durationpb.Duration `protobuf:"bytes,11,opt,name=wait_time,json=waitTime,proto3" json:"wait_time,omitempty"`          // long poll as in DequeueRequest, ignored when message_id is set
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *PeekLockRequest) Reset() {
 	*x = PeekLockRequest{}
-	mi := &file_proto_data_proto_msgTypes[9]
+	mi := &file_proto_data_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -596,7 +1025,7 @@ func (x *PeekLockRequest) String() string {
 func (*PeekLockRequest) ProtoMessage() {}
 
 func (x *PeekLockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[9]
+	mi := &file_proto_data_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,7 +1038,7 @@ func (x *PeekLockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeekLockRequest.ProtoReflect.Descriptor instead.
 func (*PeekLockRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{9}
+	return file_proto_data_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PeekLockRequest) GetNamespace() string {
@@ -633,6 +1062,7 @@ func (x *PeekLockRequest) GetMessageId() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/data.proto.
 func (x *PeekLockRequest) GetLockDuration() uint32 {
 	if x != nil {
 		return x.LockDuration
@@ -640,6 +1070,55 @@ func (x *PeekLockRequest) GetLockDuration() uint32 {
 	return 0
 }
 
+func (x *PeekLockRequest) GetSessionLockId() string {
+	if x != nil {
+		return x.SessionLockId
+	}
+	return ""
+}
+
+func (x *PeekLockRequest) GetPriorityRange() *PriorityRange {
+	if x != nil {
+		return x.PriorityRange
+	}
+	return nil
+}
+
+func (x *PeekLockRequest) GetPrioritySelection() PrioritySelection {
+	if x != nil {
+		return x.PrioritySelection
+	}
+	return PrioritySelection_PRIORITY_SELECTION_STRICT
+}
+
+func (x *PeekLockRequest) GetMaxCount() uint32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *PeekLockRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *PeekLockRequest) GetLockTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.LockTimeout
+	}
+	return nil
+}
+
+func (x *PeekLockRequest) GetWaitTime() *durationpb.Duration {
+	if x != nil {
+		return x.WaitTime
+	}
+	return nil
+}
+
 type LockedMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       *KokaqMessageResponse  `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -651,7 +1130,7 @@ type LockedMessage struct {
 
 func (x *LockedMessage) Reset() {
 	*x = LockedMessage{}
-	mi := &file_proto_data_proto_msgTypes[10]
+	mi := &file_proto_data_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -663,7 +1142,7 @@ func (x *LockedMessage) String() string {
 func (*LockedMessage) ProtoMessage() {}
 
 func (x *LockedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[10]
+	mi := &file_proto_data_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +1155,7 @@ func (x *LockedMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LockedMessage.ProtoReflect.Descriptor instead.
 func (*LockedMessage) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{10}
+	return file_proto_data_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LockedMessage) GetMessage() *KokaqMessageResponse {
@@ -709,7 +1188,7 @@ type PeekLockResponse struct {
 
 func (x *PeekLockResponse) Reset() {
 	*x = PeekLockResponse{}
-	mi := &file_proto_data_proto_msgTypes[11]
+	mi := &file_proto_data_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -721,7 +1200,7 @@ func (x *PeekLockResponse) String() string {
 func (*PeekLockResponse) ProtoMessage() {}
 
 func (x *PeekLockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[11]
+	mi := &file_proto_data_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,7 +1213,7 @@ func (x *PeekLockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeekLockResponse.ProtoReflect.Descriptor instead.
 func (*PeekLockResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{11}
+	return file_proto_data_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PeekLockResponse) GetLocked() []*LockedMessage {
@@ -751,13 +1230,14 @@ type AckRequest struct {
 	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
 	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 	LockId        string                 `protobuf:"bytes,4,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	TxnId         string                 `protobuf:"bytes,5,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"` // stage in a transaction, the message is deleted on CommitTxn
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AckRequest) Reset() {
 	*x = AckRequest{}
-	mi := &file_proto_data_proto_msgTypes[12]
+	mi := &file_proto_data_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -769,7 +1249,7 @@ func (x *AckRequest) String() string {
 func (*AckRequest) ProtoMessage() {}
 
 func (x *AckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[12]
+	mi := &file_proto_data_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +1262,7 @@ func (x *AckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AckRequest.ProtoReflect.Descriptor instead.
 func (*AckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{12}
+	return file_proto_data_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AckRequest) GetNamespace() string {
@@ -813,6 +1293,13 @@ func (x *AckRequest) GetLockId() string {
 	return ""
 }
 
+func (x *AckRequest) GetTxnId() string {
+	if x != nil {
+		return x.TxnId
+	}
+	return ""
+}
+
 type AckResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Acknowledged  bool                   `protobuf:"varint,1,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"` // optional: google.protobuf.Timestamp deleted_at = 2;
@@ -822,7 +1309,7 @@ type AckResponse struct {
 
 func (x *AckResponse) Reset() {
 	*x = AckResponse{}
-	mi := &file_proto_data_proto_msgTypes[13]
+	mi := &file_proto_data_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -834,7 +1321,7 @@ func (x *AckResponse) String() string {
 func (*AckResponse) ProtoMessage() {}
 
 func (x *AckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[13]
+	mi := &file_proto_data_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,7 +1334,7 @@ func (x *AckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AckResponse.ProtoReflect.Descriptor instead.
 func (*AckResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{13}
+	return file_proto_data_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AckResponse) GetAcknowledged() bool {
@@ -866,13 +1353,14 @@ type NackRequest struct {
 	LockId             string                 `protobuf:"bytes,4,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
 	FailureReason      FailureReason          `protobuf:"varint,5,opt,name=failure_reason,json=failureReason,proto3,enum=proto.FailureReason" json:"failure_reason,omitempty"`
 	RequeueImmediately bool                   `protobuf:"varint,6,opt,name=requeue_immediately,json=requeueImmediately,proto3" json:"requeue_immediately,omitempty"`
+	RedeliverAfter     *durationpb.Duration   `protobuf:"bytes,7,opt,name=redeliver_after,json=redeliverAfter,proto3" json:"redeliver_after,omitempty"` // overrides the queue retry_policy delay
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *NackRequest) Reset() {
 	*x = NackRequest{}
-	mi := &file_proto_data_proto_msgTypes[14]
+	mi := &file_proto_data_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -884,7 +1372,7 @@ func (x *NackRequest) String() string {
 func (*NackRequest) ProtoMessage() {}
 
 func (x *NackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[14]
+	mi := &file_proto_data_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1385,7 @@ func (x *NackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NackRequest.ProtoReflect.Descriptor instead.
 func (*NackRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{14}
+	return file_proto_data_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *NackRequest) GetNamespace() string {
@@ -942,29 +1430,1240 @@ func (x *NackRequest) GetRequeueImmediately() bool {
 	return false
 }
 
+func (x *NackRequest) GetRedeliverAfter() *durationpb.Duration {
+	if x != nil {
+		return x.RedeliverAfter
+	}
+	return nil
+}
+
 type NackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DeadLettered  bool                   `protobuf:"varint,1,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered,omitempty"`
-	Requeued      bool                   `protobuf:"varint,2,opt,name=requeued,proto3" json:"requeued,omitempty"`
+	DeadLettered  bool                   `protobuf:"varint,1,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered,omitempty"`
+	Requeued      bool                   `protobuf:"varint,2,opt,name=requeued,proto3" json:"requeued,omitempty"`
+	VisibleAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=visible_at,json=visibleAt,proto3" json:"visible_at,omitempty"`
+	RetryCount    uint32                 `protobuf:"varint,4,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NackResponse) Reset() {
+	*x = NackResponse{}
+	mi := &file_proto_data_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NackResponse) ProtoMessage() {}
+
+func (x *NackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NackResponse.ProtoReflect.Descriptor instead.
+func (*NackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *NackResponse) GetDeadLettered() bool {
+	if x != nil {
+		return x.DeadLettered
+	}
+	return false
+}
+
+func (x *NackResponse) GetRequeued() bool {
+	if x != nil {
+		return x.Requeued
+	}
+	return false
+}
+
+func (x *NackResponse) GetVisibleAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VisibleAt
+	}
+	return nil
+}
+
+func (x *NackResponse) GetRetryCount() uint32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+// Release lock explicitly
+type ReleaseLockRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue          string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	MessageId      string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	LockId         string                 `protobuf:"bytes,4,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	MakeVisibleNow bool                   `protobuf:"varint,5,opt,name=make_visible_now,json=makeVisibleNow,proto3" json:"make_visible_now,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReleaseLockRequest) Reset() {
+	*x = ReleaseLockRequest{}
+	mi := &file_proto_data_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLockRequest) ProtoMessage() {}
+
+func (x *ReleaseLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseLockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReleaseLockRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ReleaseLockRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ReleaseLockRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ReleaseLockRequest) GetLockId() string {
+	if x != nil {
+		return x.LockId
+	}
+	return ""
+}
+
+func (x *ReleaseLockRequest) GetMakeVisibleNow() bool {
+	if x != nil {
+		return x.MakeVisibleNow
+	}
+	return false
+}
+
+type ReleaseLockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Released      bool                   `protobuf:"varint,1,opt,name=released,proto3" json:"released,omitempty"`
+	VisibleAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=visible_at,json=visibleAt,proto3" json:"visible_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseLockResponse) Reset() {
+	*x = ReleaseLockResponse{}
+	mi := &file_proto_data_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLockResponse) ProtoMessage() {}
+
+func (x *ReleaseLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLockResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseLockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReleaseLockResponse) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *ReleaseLockResponse) GetVisibleAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VisibleAt
+	}
+	return nil
+}
+
+// Extend lock visibility timeout
+type ExtendVisibilityTimeoutRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue     string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	MessageId string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/data.proto.
+	AdditionalMs  uint32               `protobuf:"varint,4,opt,name=additional_ms,json=additionalMs,proto3" json:"additional_ms,omitempty"` // in milliseconds, use extend_by
+	LockId        string               `protobuf:"bytes,5,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	ExtendBy      *durationpb.Duration `protobuf:"bytes,6,opt,name=extend_by,json=extendBy,proto3" json:"extend_by,omitempty"` // wins over additional_ms when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendVisibilityTimeoutRequest) Reset() {
+	*x = ExtendVisibilityTimeoutRequest{}
+	mi := &file_proto_data_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendVisibilityTimeoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendVisibilityTimeoutRequest) ProtoMessage() {}
+
+func (x *ExtendVisibilityTimeoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendVisibilityTimeoutRequest.ProtoReflect.Descriptor instead.
+func (*ExtendVisibilityTimeoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExtendVisibilityTimeoutRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExtendVisibilityTimeoutRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ExtendVisibilityTimeoutRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/data.proto.
+func (x *ExtendVisibilityTimeoutRequest) GetAdditionalMs() uint32 {
+	if x != nil {
+		return x.AdditionalMs
+	}
+	return 0
+}
+
+func (x *ExtendVisibilityTimeoutRequest) GetLockId() string {
+	if x != nil {
+		return x.LockId
+	}
+	return ""
+}
+
+func (x *ExtendVisibilityTimeoutRequest) GetExtendBy() *durationpb.Duration {
+	if x != nil {
+		return x.ExtendBy
+	}
+	return nil
+}
+
+// Refresh (keep-alive) current timeout
+type RefreshVisibilityTimeoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	LockId        string                 `protobuf:"bytes,4,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshVisibilityTimeoutRequest) Reset() {
+	*x = RefreshVisibilityTimeoutRequest{}
+	mi := &file_proto_data_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshVisibilityTimeoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshVisibilityTimeoutRequest) ProtoMessage() {}
+
+func (x *RefreshVisibilityTimeoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshVisibilityTimeoutRequest.ProtoReflect.Descriptor instead.
+func (*RefreshVisibilityTimeoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RefreshVisibilityTimeoutRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RefreshVisibilityTimeoutRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *RefreshVisibilityTimeoutRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RefreshVisibilityTimeoutRequest) GetLockId() string {
+	if x != nil {
+		return x.LockId
+	}
+	return ""
+}
+
+// Override timeout
+type SetVisibilityTimeoutRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue     string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	MessageId string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/data.proto.
+	NewTimeoutMs  uint32               `protobuf:"varint,4,opt,name=new_timeout_ms,json=newTimeoutMs,proto3" json:"new_timeout_ms,omitempty"` // in milliseconds, use new_timeout
+	LockId        string               `protobuf:"bytes,5,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	NewTimeout    *durationpb.Duration `protobuf:"bytes,6,opt,name=new_timeout,json=newTimeout,proto3" json:"new_timeout,omitempty"` // wins over new_timeout_ms when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVisibilityTimeoutRequest) Reset() {
+	*x = SetVisibilityTimeoutRequest{}
+	mi := &file_proto_data_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVisibilityTimeoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVisibilityTimeoutRequest) ProtoMessage() {}
+
+func (x *SetVisibilityTimeoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVisibilityTimeoutRequest.ProtoReflect.Descriptor instead.
+func (*SetVisibilityTimeoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetVisibilityTimeoutRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetVisibilityTimeoutRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *SetVisibilityTimeoutRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/data.proto.
+func (x *SetVisibilityTimeoutRequest) GetNewTimeoutMs() uint32 {
+	if x != nil {
+		return x.NewTimeoutMs
+	}
+	return 0
+}
+
+func (x *SetVisibilityTimeoutRequest) GetLockId() string {
+	if x != nil {
+		return x.LockId
+	}
+	return ""
+}
+
+func (x *SetVisibilityTimeoutRequest) GetNewTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.NewTimeout
+	}
+	return nil
+}
+
+type VisibilityTimeoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LockExpiresAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=lock_expires_at,json=lockExpiresAt,proto3" json:"lock_expires_at,omitempty"`
+	Applied       bool                   `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisibilityTimeoutResponse) Reset() {
+	*x = VisibilityTimeoutResponse{}
+	mi := &file_proto_data_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisibilityTimeoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisibilityTimeoutResponse) ProtoMessage() {}
+
+func (x *VisibilityTimeoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisibilityTimeoutResponse.ProtoReflect.Descriptor instead.
+func (*VisibilityTimeoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VisibilityTimeoutResponse) GetLockExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockExpiresAt
+	}
+	return nil
+}
+
+func (x *VisibilityTimeoutResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+// Fetch one message by id, from the queue or its dead-letter queue, without locking it
+type GetMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageRequest) Reset() {
+	*x = GetMessageRequest{}
+	mi := &file_proto_data_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageRequest) ProtoMessage() {}
+
+func (x *GetMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMessageRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetMessageRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *GetMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// Admin: remove one message by id from the queue and its dead-letter queue,
+// whatever its state and regardless of any lock held on it
+type DeleteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageRequest) Reset() {
+	*x = DeleteMessageRequest{}
+	mi := &file_proto_data_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageRequest) ProtoMessage() {}
+
+func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteMessageRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteMessageRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *DeleteMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// Walk every message of a queue, page by page. Unlike Peek, a page token
+// resumes after the last message returned, so a full walk sees each message
+// that stays in place exactly once.
+type ListMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue          string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                   // 0 lets the server choose
+	PageToken      string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                 // next_page_token of the previous page, empty for the first
+	Order          MessageOrder           `protobuf:"varint,5,opt,name=order,proto3,enum=proto.MessageOrder" json:"order,omitempty"`                 // must not change between pages
+	IncludePayload bool                   `protobuf:"varint,6,opt,name=include_payload,json=includePayload,proto3" json:"include_payload,omitempty"` // payloads are omitted unless asked for
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMessagesRequest) Reset() {
+	*x = ListMessagesRequest{}
+	mi := &file_proto_data_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesRequest) ProtoMessage() {}
+
+func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListMessagesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListMessagesRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ListMessagesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMessagesRequest) GetOrder() MessageOrder {
+	if x != nil {
+		return x.Order
+	}
+	return MessageOrder_MESSAGE_ORDER_PRIORITY
+}
+
+func (x *ListMessagesRequest) GetIncludePayload() bool {
+	if x != nil {
+		return x.IncludePayload
+	}
+	return false
+}
+
+type ListMessagesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Messages      []*KokaqMessageResponse `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessagesResponse) Reset() {
+	*x = ListMessagesResponse{}
+	mi := &file_proto_data_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesResponse) ProtoMessage() {}
+
+func (x *ListMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMessagesResponse) GetMessages() []*KokaqMessageResponse {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListMessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListLockedMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locked        []*LockedMessage       `protobuf:"bytes,1,rep,name=locked,proto3" json:"locked,omitempty"` // lock_id is never disclosed
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLockedMessagesResponse) Reset() {
+	*x = ListLockedMessagesResponse{}
+	mi := &file_proto_data_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLockedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLockedMessagesResponse) ProtoMessage() {}
+
+func (x *ListLockedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLockedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListLockedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListLockedMessagesResponse) GetLocked() []*LockedMessage {
+	if x != nil {
+		return x.Locked
+	}
+	return nil
+}
+
+func (x *ListLockedMessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Change the priority of a message already in the queue
+type ChangePriorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	NewPriority   uint64                 `protobuf:"varint,4,opt,name=new_priority,json=newPriority,proto3" json:"new_priority,omitempty"` // must lie within the queue's min_priority and max_priority
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePriorityRequest) Reset() {
+	*x = ChangePriorityRequest{}
+	mi := &file_proto_data_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePriorityRequest) ProtoMessage() {}
+
+func (x *ChangePriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePriorityRequest.ProtoReflect.Descriptor instead.
+func (*ChangePriorityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChangePriorityRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ChangePriorityRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ChangePriorityRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChangePriorityRequest) GetNewPriority() uint64 {
+	if x != nil {
+		return x.NewPriority
+	}
+	return 0
+}
+
+type ChangePriorityResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Changed          bool                   `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+	PreviousPriority uint64                 `protobuf:"varint,2,opt,name=previous_priority,json=previousPriority,proto3" json:"previous_priority,omitempty"`
+	Status           *StatusResponse        `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ChangePriorityResponse) Reset() {
+	*x = ChangePriorityResponse{}
+	mi := &file_proto_data_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePriorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePriorityResponse) ProtoMessage() {}
+
+func (x *ChangePriorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePriorityResponse.ProtoReflect.Descriptor instead.
+func (*ChangePriorityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChangePriorityResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *ChangePriorityResponse) GetPreviousPriority() uint64 {
+	if x != nil {
+		return x.PreviousPriority
+	}
+	return 0
+}
+
+func (x *ChangePriorityResponse) GetStatus() *StatusResponse {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Change the priority of every message matching a filter
+type BulkChangePriorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"` // required, see package filter
+	NewPriority   uint64                 `protobuf:"varint,4,opt,name=new_priority,json=newPriority,proto3" json:"new_priority,omitempty"`
+	MaxCount      uint32                 `protobuf:"varint,5,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"` // 0 means no limit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkChangePriorityRequest) Reset() {
+	*x = BulkChangePriorityRequest{}
+	mi := &file_proto_data_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkChangePriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkChangePriorityRequest) ProtoMessage() {}
+
+func (x *BulkChangePriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkChangePriorityRequest.ProtoReflect.Descriptor instead.
+func (*BulkChangePriorityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BulkChangePriorityRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BulkChangePriorityRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *BulkChangePriorityRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *BulkChangePriorityRequest) GetNewPriority() uint64 {
+	if x != nil {
+		return x.NewPriority
+	}
+	return 0
+}
+
+func (x *BulkChangePriorityRequest) GetMaxCount() uint32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+type BulkChangePriorityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangedCount  uint64                 `protobuf:"varint,1,opt,name=changed_count,json=changedCount,proto3" json:"changed_count,omitempty"`
+	Status        *StatusResponse        `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkChangePriorityResponse) Reset() {
+	*x = BulkChangePriorityResponse{}
+	mi := &file_proto_data_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkChangePriorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkChangePriorityResponse) ProtoMessage() {}
+
+func (x *BulkChangePriorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkChangePriorityResponse.ProtoReflect.Descriptor instead.
+func (*BulkChangePriorityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BulkChangePriorityResponse) GetChangedCount() uint64 {
+	if x != nil {
+		return x.ChangedCount
+	}
+	return 0
+}
+
+func (x *BulkChangePriorityResponse) GetStatus() *StatusResponse {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Accept a session - lock a message group for exclusive, ordered delivery
+type AcceptSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // empty accepts the next unlocked session
+	LockDuration  *durationpb.Duration   `protobuf:"bytes,4,opt,name=lock_duration,json=lockDuration,proto3" json:"lock_duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptSessionRequest) Reset() {
+	*x = AcceptSessionRequest{}
+	mi := &file_proto_data_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSessionRequest) ProtoMessage() {}
+
+func (x *AcceptSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSessionRequest.ProtoReflect.Descriptor instead.
+func (*AcceptSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AcceptSessionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AcceptSessionRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *AcceptSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AcceptSessionRequest) GetLockDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LockDuration
+	}
+	return nil
+}
+
+// Renew a session lock before it expires
+type RenewSessionLockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionLockId string                 `protobuf:"bytes,4,opt,name=session_lock_id,json=sessionLockId,proto3" json:"session_lock_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewSessionLockRequest) Reset() {
+	*x = RenewSessionLockRequest{}
+	mi := &file_proto_data_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewSessionLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewSessionLockRequest) ProtoMessage() {}
+
+func (x *RenewSessionLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewSessionLockRequest.ProtoReflect.Descriptor instead.
+func (*RenewSessionLockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RenewSessionLockRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RenewSessionLockRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *RenewSessionLockRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RenewSessionLockRequest) GetSessionLockId() string {
+	if x != nil {
+		return x.SessionLockId
+	}
+	return ""
+}
+
+type SessionLockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionLockId string                 `protobuf:"bytes,2,opt,name=session_lock_id,json=sessionLockId,proto3" json:"session_lock_id,omitempty"`
+	LockExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=lock_expires_at,json=lockExpiresAt,proto3" json:"lock_expires_at,omitempty"`
+	Applied       bool                   `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NackResponse) Reset() {
-	*x = NackResponse{}
-	mi := &file_proto_data_proto_msgTypes[15]
+func (x *SessionLockResponse) Reset() {
+	*x = SessionLockResponse{}
+	mi := &file_proto_data_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NackResponse) String() string {
+func (x *SessionLockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NackResponse) ProtoMessage() {}
+func (*SessionLockResponse) ProtoMessage() {}
 
-func (x *NackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[15]
+func (x *SessionLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,52 +2674,65 @@ func (x *NackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NackResponse.ProtoReflect.Descriptor instead.
-func (*NackResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SessionLockResponse.ProtoReflect.Descriptor instead.
+func (*SessionLockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *NackResponse) GetDeadLettered() bool {
+func (x *SessionLockResponse) GetSessionId() string {
 	if x != nil {
-		return x.DeadLettered
+		return x.SessionId
 	}
-	return false
+	return ""
 }
 
-func (x *NackResponse) GetRequeued() bool {
+func (x *SessionLockResponse) GetSessionLockId() string {
 	if x != nil {
-		return x.Requeued
+		return x.SessionLockId
+	}
+	return ""
+}
+
+func (x *SessionLockResponse) GetLockExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockExpiresAt
+	}
+	return nil
+}
+
+func (x *SessionLockResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
 	}
 	return false
 }
 
-// Release lock explicitly
-type ReleaseLockRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Queue          string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
-	MessageId      string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	LockId         string                 `protobuf:"bytes,4,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
-	MakeVisibleNow bool                   `protobuf:"varint,5,opt,name=make_visible_now,json=makeVisibleNow,proto3" json:"make_visible_now,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Read opaque state stored against a session
+type GetSessionStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionLockId string                 `protobuf:"bytes,4,opt,name=session_lock_id,json=sessionLockId,proto3" json:"session_lock_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseLockRequest) Reset() {
-	*x = ReleaseLockRequest{}
-	mi := &file_proto_data_proto_msgTypes[16]
+func (x *GetSessionStateRequest) Reset() {
+	*x = GetSessionStateRequest{}
+	mi := &file_proto_data_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseLockRequest) String() string {
+func (x *GetSessionStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseLockRequest) ProtoMessage() {}
+func (*GetSessionStateRequest) ProtoMessage() {}
 
-func (x *ReleaseLockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[16]
+func (x *GetSessionStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,69 +2743,62 @@ func (x *ReleaseLockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseLockRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseLockRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetSessionStateRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionStateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ReleaseLockRequest) GetNamespace() string {
+func (x *GetSessionStateRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ReleaseLockRequest) GetQueue() string {
+func (x *GetSessionStateRequest) GetQueue() string {
 	if x != nil {
 		return x.Queue
 	}
 	return ""
 }
 
-func (x *ReleaseLockRequest) GetMessageId() string {
+func (x *GetSessionStateRequest) GetSessionId() string {
 	if x != nil {
-		return x.MessageId
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *ReleaseLockRequest) GetLockId() string {
+func (x *GetSessionStateRequest) GetSessionLockId() string {
 	if x != nil {
-		return x.LockId
+		return x.SessionLockId
 	}
 	return ""
 }
 
-func (x *ReleaseLockRequest) GetMakeVisibleNow() bool {
-	if x != nil {
-		return x.MakeVisibleNow
-	}
-	return false
-}
-
-type ReleaseLockResponse struct {
+type GetSessionStateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Released      bool                   `protobuf:"varint,1,opt,name=released,proto3" json:"released,omitempty"`
-	VisibleAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=visible_at,json=visibleAt,proto3" json:"visible_at,omitempty"`
+	State         []byte                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Status        *StatusResponse        `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseLockResponse) Reset() {
-	*x = ReleaseLockResponse{}
-	mi := &file_proto_data_proto_msgTypes[17]
+func (x *GetSessionStateResponse) Reset() {
+	*x = GetSessionStateResponse{}
+	mi := &file_proto_data_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseLockResponse) String() string {
+func (x *GetSessionStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseLockResponse) ProtoMessage() {}
+func (*GetSessionStateResponse) ProtoMessage() {}
 
-func (x *ReleaseLockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[17]
+func (x *GetSessionStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,52 +2809,52 @@ func (x *ReleaseLockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseLockResponse.ProtoReflect.Descriptor instead.
-func (*ReleaseLockResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetSessionStateResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionStateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ReleaseLockResponse) GetReleased() bool {
+func (x *GetSessionStateResponse) GetState() []byte {
 	if x != nil {
-		return x.Released
+		return x.State
 	}
-	return false
+	return nil
 }
 
-func (x *ReleaseLockResponse) GetVisibleAt() *timestamppb.Timestamp {
+func (x *GetSessionStateResponse) GetStatus() *StatusResponse {
 	if x != nil {
-		return x.VisibleAt
+		return x.Status
 	}
 	return nil
 }
 
-// Extend lock visibility timeout
-type ExtendVisibilityTimeoutRequest struct {
+// Replace opaque state stored against a session
+type SetSessionStateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
-	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	AdditionalMs  uint32                 `protobuf:"varint,4,opt,name=additional_ms,json=additionalMs,proto3" json:"additional_ms,omitempty"`
-	LockId        string                 `protobuf:"bytes,5,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionLockId string                 `protobuf:"bytes,4,opt,name=session_lock_id,json=sessionLockId,proto3" json:"session_lock_id,omitempty"`
+	State         []byte                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExtendVisibilityTimeoutRequest) Reset() {
-	*x = ExtendVisibilityTimeoutRequest{}
-	mi := &file_proto_data_proto_msgTypes[18]
+func (x *SetSessionStateRequest) Reset() {
+	*x = SetSessionStateRequest{}
+	mi := &file_proto_data_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExtendVisibilityTimeoutRequest) String() string {
+func (x *SetSessionStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtendVisibilityTimeoutRequest) ProtoMessage() {}
+func (*SetSessionStateRequest) ProtoMessage() {}
 
-func (x *ExtendVisibilityTimeoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[18]
+func (x *SetSessionStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1160,72 +2865,71 @@ func (x *ExtendVisibilityTimeoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExtendVisibilityTimeoutRequest.ProtoReflect.Descriptor instead.
-func (*ExtendVisibilityTimeoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetSessionStateRequest.ProtoReflect.Descriptor instead.
+func (*SetSessionStateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ExtendVisibilityTimeoutRequest) GetNamespace() string {
+func (x *SetSessionStateRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ExtendVisibilityTimeoutRequest) GetQueue() string {
+func (x *SetSessionStateRequest) GetQueue() string {
 	if x != nil {
 		return x.Queue
 	}
 	return ""
 }
 
-func (x *ExtendVisibilityTimeoutRequest) GetMessageId() string {
+func (x *SetSessionStateRequest) GetSessionId() string {
 	if x != nil {
-		return x.MessageId
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *ExtendVisibilityTimeoutRequest) GetAdditionalMs() uint32 {
+func (x *SetSessionStateRequest) GetSessionLockId() string {
 	if x != nil {
-		return x.AdditionalMs
+		return x.SessionLockId
 	}
-	return 0
+	return ""
 }
 
-func (x *ExtendVisibilityTimeoutRequest) GetLockId() string {
+func (x *SetSessionStateRequest) GetState() []byte {
 	if x != nil {
-		return x.LockId
+		return x.State
 	}
-	return ""
+	return nil
 }
 
-// Refresh (keep-alive) current timeout
-type RefreshVisibilityTimeoutRequest struct {
+// Transactions group Enqueue and Ack calls across the queues of one shard so
+// they take effect together on CommitTxn or not at all. A transaction not
+// committed within its timeout is aborted.
+type BeginTxnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
-	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	LockId        string                 `protobuf:"bytes,4,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"` // 0 lets the server choose
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshVisibilityTimeoutRequest) Reset() {
-	*x = RefreshVisibilityTimeoutRequest{}
-	mi := &file_proto_data_proto_msgTypes[19]
+func (x *BeginTxnRequest) Reset() {
+	*x = BeginTxnRequest{}
+	mi := &file_proto_data_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshVisibilityTimeoutRequest) String() string {
+func (x *BeginTxnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshVisibilityTimeoutRequest) ProtoMessage() {}
+func (*BeginTxnRequest) ProtoMessage() {}
 
-func (x *RefreshVisibilityTimeoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[19]
+func (x *BeginTxnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,66 +2940,41 @@ func (x *RefreshVisibilityTimeoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshVisibilityTimeoutRequest.ProtoReflect.Descriptor instead.
-func (*RefreshVisibilityTimeoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *RefreshVisibilityTimeoutRequest) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-func (x *RefreshVisibilityTimeoutRequest) GetQueue() string {
-	if x != nil {
-		return x.Queue
-	}
-	return ""
-}
-
-func (x *RefreshVisibilityTimeoutRequest) GetMessageId() string {
-	if x != nil {
-		return x.MessageId
-	}
-	return ""
+// Deprecated: Use BeginTxnRequest.ProtoReflect.Descriptor instead.
+func (*BeginTxnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *RefreshVisibilityTimeoutRequest) GetLockId() string {
+func (x *BeginTxnRequest) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.LockId
+		return x.Timeout
 	}
-	return ""
+	return nil
 }
 
-// Override timeout
-type SetVisibilityTimeoutRequest struct {
+type BeginTxnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
-	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	NewTimeoutMs  uint32                 `protobuf:"varint,4,opt,name=new_timeout_ms,json=newTimeoutMs,proto3" json:"new_timeout_ms,omitempty"`
-	LockId        string                 `protobuf:"bytes,5,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	TxnId         string                 `protobuf:"bytes,1,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetVisibilityTimeoutRequest) Reset() {
-	*x = SetVisibilityTimeoutRequest{}
-	mi := &file_proto_data_proto_msgTypes[20]
+func (x *BeginTxnResponse) Reset() {
+	*x = BeginTxnResponse{}
+	mi := &file_proto_data_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetVisibilityTimeoutRequest) String() string {
+func (x *BeginTxnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetVisibilityTimeoutRequest) ProtoMessage() {}
+func (*BeginTxnResponse) ProtoMessage() {}
 
-func (x *SetVisibilityTimeoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[20]
+func (x *BeginTxnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1306,69 +2985,47 @@ func (x *SetVisibilityTimeoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetVisibilityTimeoutRequest.ProtoReflect.Descriptor instead.
-func (*SetVisibilityTimeoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *SetVisibilityTimeoutRequest) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-func (x *SetVisibilityTimeoutRequest) GetQueue() string {
-	if x != nil {
-		return x.Queue
-	}
-	return ""
+// Deprecated: Use BeginTxnResponse.ProtoReflect.Descriptor instead.
+func (*BeginTxnResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SetVisibilityTimeoutRequest) GetMessageId() string {
+func (x *BeginTxnResponse) GetTxnId() string {
 	if x != nil {
-		return x.MessageId
+		return x.TxnId
 	}
 	return ""
 }
 
-func (x *SetVisibilityTimeoutRequest) GetNewTimeoutMs() uint32 {
-	if x != nil {
-		return x.NewTimeoutMs
-	}
-	return 0
-}
-
-func (x *SetVisibilityTimeoutRequest) GetLockId() string {
+func (x *BeginTxnResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LockId
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-type VisibilityTimeoutResponse struct {
+type TxnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LockExpiresAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=lock_expires_at,json=lockExpiresAt,proto3" json:"lock_expires_at,omitempty"`
-	Applied       bool                   `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	TxnId         string                 `protobuf:"bytes,1,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VisibilityTimeoutResponse) Reset() {
-	*x = VisibilityTimeoutResponse{}
-	mi := &file_proto_data_proto_msgTypes[21]
+func (x *TxnRequest) Reset() {
+	*x = TxnRequest{}
+	mi := &file_proto_data_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VisibilityTimeoutResponse) String() string {
+func (x *TxnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VisibilityTimeoutResponse) ProtoMessage() {}
+func (*TxnRequest) ProtoMessage() {}
 
-func (x *VisibilityTimeoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[21]
+func (x *TxnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1379,23 +3036,16 @@ func (x *VisibilityTimeoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VisibilityTimeoutResponse.ProtoReflect.Descriptor instead.
-func (*VisibilityTimeoutResponse) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *VisibilityTimeoutResponse) GetLockExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LockExpiresAt
-	}
-	return nil
+// Deprecated: Use TxnRequest.ProtoReflect.Descriptor instead.
+func (*TxnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *VisibilityTimeoutResponse) GetApplied() bool {
+func (x *TxnRequest) GetTxnId() string {
 	if x != nil {
-		return x.Applied
+		return x.TxnId
 	}
-	return false
+	return ""
 }
 
 type KokaqNewQueueRequest struct {
@@ -1408,7 +3058,7 @@ type KokaqNewQueueRequest struct {
 
 func (x *KokaqNewQueueRequest) Reset() {
 	*x = KokaqNewQueueRequest{}
-	mi := &file_proto_data_proto_msgTypes[22]
+	mi := &file_proto_data_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1420,7 +3070,7 @@ func (x *KokaqNewQueueRequest) String() string {
 func (*KokaqNewQueueRequest) ProtoMessage() {}
 
 func (x *KokaqNewQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_data_proto_msgTypes[22]
+	mi := &file_proto_data_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1433,7 +3083,7 @@ func (x *KokaqNewQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KokaqNewQueueRequest.ProtoReflect.Descriptor instead.
 func (*KokaqNewQueueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_data_proto_rawDescGZIP(), []int{22}
+	return file_proto_data_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *KokaqNewQueueRequest) GetRequest() *KokaqQueueRequest {
@@ -1454,12 +3104,28 @@ var File_proto_data_proto protoreflect.FileDescriptor
 
 const file_proto_data_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/data.proto\x12\x05proto\x1a\x12proto/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x01\n" +
+	"\x10proto/data.proto\x12\x05proto\x1a\x12proto/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"M\n" +
+	"\rPriorityRange\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x04H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x04H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"P\n" +
+	"\x10PayloadReference\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x04R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\"\xdc\x02\n" +
 	"\x13KokaqMessageHeaders\x12!\n" +
 	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12%\n" +
 	"\x0ecorrelation_id\x18\x02 \x01(\tR\rcorrelationId\x12\x16\n" +
 	"\x06source\x18\x03 \x01(\tR\x06source\x12;\n" +
-	"\x0efailure_reason\x18\x04 \x01(\x0e2\x14.proto.FailureReasonR\rfailureReason\"\xd4\x01\n" +
+	"\x0efailure_reason\x18\x04 \x01(\x0e2\x14.proto.FailureReasonR\rfailureReason\x12J\n" +
+	"\n" +
+	"properties\x18\x05 \x03(\v2*.proto.KokaqMessageHeaders.PropertiesEntryR\n" +
+	"properties\x12\x1b\n" +
+	"\thop_count\x18\x06 \x01(\rR\bhopCount\x1a=\n" +
+	"\x0fPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x80\x03\n" +
 	"\x13KokaqMessageRequest\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1c\n" +
@@ -1467,57 +3133,85 @@ const file_proto_data_proto_rawDesc = "" +
 	"\x05queue\x18\x03 \x01(\tR\x05queue\x12\x1a\n" +
 	"\bpriority\x18\x04 \x01(\x04R\bpriority\x12\x18\n" +
 	"\apayload\x18\x05 \x01(\fR\apayload\x124\n" +
-	"\aheaders\x18\x06 \x01(\v2\x1a.proto.KokaqMessageHeadersR\aheaders\"\x92\x03\n" +
+	"\aheaders\x18\x06 \x01(\v2\x1a.proto.KokaqMessageHeadersR\aheaders\x12;\n" +
+	"\ftime_to_live\x18\a \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"timeToLive\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\b \x01(\tR\tsessionId\x128\n" +
+	"\vpayload_ref\x18\t \x01(\v2\x17.proto.PayloadReferenceR\n" +
+	"payloadRef\x12\x14\n" +
+	"\x05topic\x18\n" +
+	" \x01(\tR\x05topic\"\xff\x03\n" +
 	"\x14KokaqMessageResponse\x124\n" +
 	"\amessage\x18\x01 \x01(\v2\x1a.proto.KokaqMessageRequestR\amessage\x129\n" +
 	"\n" +
 	"created_on\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x12?\n" +
 	"\rlast_dequeued\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\flastDequeued\x122\n" +
 	"\x06expiry\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x12D\n" +
-	"\x10dead_lettered_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0edeadLetteredAt\x12-\n" +
-	"\x12visibility_timeout\x18\x06 \x01(\rR\x11visibilityTimeout\x12\x1f\n" +
+	"\x10dead_lettered_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0edeadLetteredAt\x121\n" +
+	"\x12visibility_timeout\x18\x06 \x01(\rB\x02\x18\x01R\x11visibilityTimeout\x12\x1f\n" +
 	"\vretry_count\x18\a \x01(\rR\n" +
-	"retryCount\"F\n" +
+	"retryCount\x12<\n" +
+	"\flock_timeout\x18\b \x01(\v2\x19.google.protobuf.DurationR\vlockTimeout\x12)\n" +
+	"\x05state\x18\t \x01(\x0e2\x13.proto.MessageStateR\x05state\"]\n" +
 	"\x0eEnqueueRequest\x124\n" +
-	"\amessage\x18\x01 \x01(\v2\x1a.proto.KokaqMessageRequestR\amessage\"m\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.proto.KokaqMessageRequestR\amessage\x12\x15\n" +
+	"\x06txn_id\x18\x02 \x01(\tR\x05txnId\"\xa8\x01\n" +
 	"\x0fEnqueueResponse\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12;\n" +
 	"\venqueued_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"enqueuedAt\"a\n" +
+	"enqueuedAt\x12!\n" +
+	"\fis_duplicate\x18\x03 \x01(\bR\visDuplicate\x12\x16\n" +
+	"\x06queues\x18\x04 \x03(\tR\x06queues\"\xdf\x02\n" +
 	"\x0eDequeueRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1b\n" +
-	"\tmax_count\x18\x03 \x01(\rR\bmaxCount\"J\n" +
+	"\tmax_count\x18\x03 \x01(\rR\bmaxCount\x12&\n" +
+	"\x0fsession_lock_id\x18\x04 \x01(\tR\rsessionLockId\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\x12;\n" +
+	"\x0epriority_range\x18\x06 \x01(\v2\x14.proto.PriorityRangeR\rpriorityRange\x12G\n" +
+	"\x12priority_selection\x18\a \x01(\x0e2\x18.proto.PrioritySelectionR\x11prioritySelection\x126\n" +
+	"\twait_time\x18\b \x01(\v2\x19.google.protobuf.DurationR\bwaitTime\"J\n" +
 	"\x0fDequeueResponse\x127\n" +
-	"\bmessages\x18\x01 \x03(\v2\x1b.proto.KokaqMessageResponseR\bmessages\"W\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1b.proto.KokaqMessageResponseR\bmessages\"o\n" +
 	"\vPeekRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\rR\x05count\"G\n" +
+	"\x05count\x18\x03 \x01(\rR\x05count\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\"G\n" +
 	"\fPeekResponse\x127\n" +
-	"\bmessages\x18\x01 \x03(\v2\x1b.proto.KokaqMessageResponseR\bmessages\"\x89\x01\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1b.proto.KokaqMessageResponseR\bmessages\"\xe6\x03\n" +
 	"\x0fPeekLockRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x03 \x01(\tR\tmessageId\x12#\n" +
-	"\rlock_duration\x18\x04 \x01(\rR\flockDuration\"\xa3\x01\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12'\n" +
+	"\rlock_duration\x18\x04 \x01(\rB\x02\x18\x01R\flockDuration\x12&\n" +
+	"\x0fsession_lock_id\x18\x05 \x01(\tR\rsessionLockId\x12;\n" +
+	"\x0epriority_range\x18\x06 \x01(\v2\x14.proto.PriorityRangeR\rpriorityRange\x12G\n" +
+	"\x12priority_selection\x18\a \x01(\x0e2\x18.proto.PrioritySelectionR\x11prioritySelection\x12\x1b\n" +
+	"\tmax_count\x18\b \x01(\rR\bmaxCount\x12\x16\n" +
+	"\x06filter\x18\t \x01(\tR\x06filter\x12<\n" +
+	"\flock_timeout\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\vlockTimeout\x126\n" +
+	"\twait_time\x18\v \x01(\v2\x19.google.protobuf.DurationR\bwaitTime\"\xa3\x01\n" +
 	"\rLockedMessage\x125\n" +
 	"\amessage\x18\x01 \x01(\v2\x1b.proto.KokaqMessageResponseR\amessage\x12\x17\n" +
 	"\alock_id\x18\x02 \x01(\tR\x06lockId\x12B\n" +
 	"\x0flock_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlockExpiresAt\"@\n" +
 	"\x10PeekLockResponse\x12,\n" +
-	"\x06locked\x18\x01 \x03(\v2\x14.proto.LockedMessageR\x06locked\"x\n" +
+	"\x06locked\x18\x01 \x03(\v2\x14.proto.LockedMessageR\x06locked\"\x8f\x01\n" +
 	"\n" +
 	"AckRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x03 \x01(\tR\tmessageId\x12\x17\n" +
-	"\alock_id\x18\x04 \x01(\tR\x06lockId\"1\n" +
+	"\alock_id\x18\x04 \x01(\tR\x06lockId\x12\x15\n" +
+	"\x06txn_id\x18\x05 \x01(\tR\x05txnId\"1\n" +
 	"\vAckResponse\x12\"\n" +
-	"\facknowledged\x18\x01 \x01(\bR\facknowledged\"\xe7\x01\n" +
+	"\facknowledged\x18\x01 \x01(\bR\facknowledged\"\xab\x02\n" +
 	"\vNackRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
@@ -1525,10 +3219,15 @@ const file_proto_data_proto_rawDesc = "" +
 	"message_id\x18\x03 \x01(\tR\tmessageId\x12\x17\n" +
 	"\alock_id\x18\x04 \x01(\tR\x06lockId\x12;\n" +
 	"\x0efailure_reason\x18\x05 \x01(\x0e2\x14.proto.FailureReasonR\rfailureReason\x12/\n" +
-	"\x13requeue_immediately\x18\x06 \x01(\bR\x12requeueImmediately\"O\n" +
+	"\x13requeue_immediately\x18\x06 \x01(\bR\x12requeueImmediately\x12B\n" +
+	"\x0fredeliver_after\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0eredeliverAfter\"\xab\x01\n" +
 	"\fNackResponse\x12#\n" +
 	"\rdead_lettered\x18\x01 \x01(\bR\fdeadLettered\x12\x1a\n" +
-	"\brequeued\x18\x02 \x01(\bR\brequeued\"\xaa\x01\n" +
+	"\brequeued\x18\x02 \x01(\bR\brequeued\x129\n" +
+	"\n" +
+	"visible_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tvisibleAt\x12\x1f\n" +
+	"\vretry_count\x18\x04 \x01(\rR\n" +
+	"retryCount\"\xaa\x01\n" +
 	"\x12ReleaseLockRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
@@ -1539,37 +3238,140 @@ const file_proto_data_proto_rawDesc = "" +
 	"\x13ReleaseLockResponse\x12\x1a\n" +
 	"\breleased\x18\x01 \x01(\bR\breleased\x129\n" +
 	"\n" +
-	"visible_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tvisibleAt\"\xb1\x01\n" +
+	"visible_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tvisibleAt\"\xed\x01\n" +
 	"\x1eExtendVisibilityTimeoutRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x03 \x01(\tR\tmessageId\x12#\n" +
-	"\radditional_ms\x18\x04 \x01(\rR\fadditionalMs\x12\x17\n" +
-	"\alock_id\x18\x05 \x01(\tR\x06lockId\"\x8d\x01\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12'\n" +
+	"\radditional_ms\x18\x04 \x01(\rB\x02\x18\x01R\fadditionalMs\x12\x17\n" +
+	"\alock_id\x18\x05 \x01(\tR\x06lockId\x126\n" +
+	"\textend_by\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bextendBy\"\x8d\x01\n" +
 	"\x1fRefreshVisibilityTimeoutRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x03 \x01(\tR\tmessageId\x12\x17\n" +
-	"\alock_id\x18\x04 \x01(\tR\x06lockId\"\xaf\x01\n" +
+	"\alock_id\x18\x04 \x01(\tR\x06lockId\"\xef\x01\n" +
 	"\x1bSetVisibilityTimeoutRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x03 \x01(\tR\tmessageId\x12$\n" +
-	"\x0enew_timeout_ms\x18\x04 \x01(\rR\fnewTimeoutMs\x12\x17\n" +
-	"\alock_id\x18\x05 \x01(\tR\x06lockId\"y\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12(\n" +
+	"\x0enew_timeout_ms\x18\x04 \x01(\rB\x02\x18\x01R\fnewTimeoutMs\x12\x17\n" +
+	"\alock_id\x18\x05 \x01(\tR\x06lockId\x12:\n" +
+	"\vnew_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"newTimeout\"y\n" +
 	"\x19VisibilityTimeoutResponse\x12B\n" +
 	"\x0flock_expires_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rlockExpiresAt\x12\x18\n" +
-	"\aapplied\x18\x02 \x01(\bR\aapplied\"e\n" +
+	"\aapplied\x18\x02 \x01(\bR\aapplied\"f\n" +
+	"\x11GetMessageRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\"i\n" +
+	"\x14DeleteMessageRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\"\xd9\x01\n" +
+	"\x13ListMessagesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12)\n" +
+	"\x05order\x18\x05 \x01(\x0e2\x13.proto.MessageOrderR\x05order\x12'\n" +
+	"\x0finclude_payload\x18\x06 \x01(\bR\x0eincludePayload\"w\n" +
+	"\x14ListMessagesResponse\x127\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1b.proto.KokaqMessageResponseR\bmessages\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"r\n" +
+	"\x1aListLockedMessagesResponse\x12,\n" +
+	"\x06locked\x18\x01 \x03(\v2\x14.proto.LockedMessageR\x06locked\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8d\x01\n" +
+	"\x15ChangePriorityRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12!\n" +
+	"\fnew_priority\x18\x04 \x01(\x04R\vnewPriority\"\x8e\x01\n" +
+	"\x16ChangePriorityResponse\x12\x18\n" +
+	"\achanged\x18\x01 \x01(\bR\achanged\x12+\n" +
+	"\x11previous_priority\x18\x02 \x01(\x04R\x10previousPriority\x12-\n" +
+	"\x06status\x18\x03 \x01(\v2\x15.proto.StatusResponseR\x06status\"\xa7\x01\n" +
+	"\x19BulkChangePriorityRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12!\n" +
+	"\fnew_priority\x18\x04 \x01(\x04R\vnewPriority\x12\x1b\n" +
+	"\tmax_count\x18\x05 \x01(\rR\bmaxCount\"p\n" +
+	"\x1aBulkChangePriorityResponse\x12#\n" +
+	"\rchanged_count\x18\x01 \x01(\x04R\fchangedCount\x12-\n" +
+	"\x06status\x18\x02 \x01(\v2\x15.proto.StatusResponseR\x06status\"\xa9\x01\n" +
+	"\x14AcceptSessionRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12>\n" +
+	"\rlock_duration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\flockDuration\"\x94\x01\n" +
+	"\x17RenewSessionLockRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12&\n" +
+	"\x0fsession_lock_id\x18\x04 \x01(\tR\rsessionLockId\"\xba\x01\n" +
+	"\x13SessionLockResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12&\n" +
+	"\x0fsession_lock_id\x18\x02 \x01(\tR\rsessionLockId\x12B\n" +
+	"\x0flock_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlockExpiresAt\x12\x18\n" +
+	"\aapplied\x18\x04 \x01(\bR\aapplied\"\x93\x01\n" +
+	"\x16GetSessionStateRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12&\n" +
+	"\x0fsession_lock_id\x18\x04 \x01(\tR\rsessionLockId\"^\n" +
+	"\x17GetSessionStateResponse\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\fR\x05state\x12-\n" +
+	"\x06status\x18\x02 \x01(\v2\x15.proto.StatusResponseR\x06status\"\xa9\x01\n" +
+	"\x16SetSessionStateRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12&\n" +
+	"\x0fsession_lock_id\x18\x04 \x01(\tR\rsessionLockId\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\fR\x05state\"F\n" +
+	"\x0fBeginTxnRequest\x123\n" +
+	"\atimeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"d\n" +
+	"\x10BeginTxnResponse\x12\x15\n" +
+	"\x06txn_id\x18\x01 \x01(\tR\x05txnId\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"#\n" +
+	"\n" +
+	"TxnRequest\x12\x15\n" +
+	"\x06txn_id\x18\x01 \x01(\tR\x05txnId\"e\n" +
 	"\x14KokaqNewQueueRequest\x122\n" +
 	"\arequest\x18\x01 \x01(\v2\x18.proto.KokaqQueueRequestR\arequest\x12\x19\n" +
-	"\bshard_id\x18\x02 \x01(\x04R\ashardId2\xdf\a\n" +
+	"\bshard_id\x18\x02 \x01(\x04R\ashardId*X\n" +
+	"\x11PrioritySelection\x12\x1d\n" +
+	"\x19PRIORITY_SELECTION_STRICT\x10\x00\x12$\n" +
+	" PRIORITY_SELECTION_WEIGHTED_FAIR\x10\x01*\xa0\x01\n" +
+	"\fMessageState\x12\x1d\n" +
+	"\x19MESSAGE_STATE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15MESSAGE_STATE_VISIBLE\x10\x01\x12\x18\n" +
+	"\x14MESSAGE_STATE_LOCKED\x10\x02\x12\x1b\n" +
+	"\x17MESSAGE_STATE_SCHEDULED\x10\x03\x12\x1f\n" +
+	"\x1bMESSAGE_STATE_DEAD_LETTERED\x10\x04*J\n" +
+	"\fMessageOrder\x12\x1a\n" +
+	"\x16MESSAGE_ORDER_PRIORITY\x10\x00\x12\x1e\n" +
+	"\x1aMESSAGE_ORDER_ENQUEUE_TIME\x10\x012\xd5\x0f\n" +
 	"\x0eKokaqDataPlane\x12=\n" +
 	"\x03New\x12\x1b.proto.KokaqNewQueueRequest\x1a\x19.proto.KokaqQueueResponse\x12:\n" +
 	"\x03Get\x12\x18.proto.KokaqQueueRequest\x1a\x19.proto.KokaqQueueResponse\x12?\n" +
-	"\bGetStats\x12\x18.proto.KokaqQueueRequest\x1a\x19.proto.KokaqStatsResponse\x129\n" +
+	"\bGetStats\x12\x18.proto.KokaqQueueRequest\x1a\x19.proto.KokaqStatsResponse\x12C\n" +
+	"\n" +
+	"WatchStats\x12\x18.proto.WatchStatsRequest\x1a\x19.proto.KokaqStatsResponse0\x01\x129\n" +
 	"\x06Delete\x12\x18.proto.KokaqQueueRequest\x1a\x15.proto.StatusResponse\x128\n" +
 	"\x05Clear\x12\x18.proto.KokaqQueueRequest\x1a\x15.proto.StatusResponse\x128\n" +
 	"\aEnqueue\x12\x15.proto.EnqueueRequest\x1a\x16.proto.EnqueueResponse\x128\n" +
@@ -1581,7 +3383,21 @@ const file_proto_data_proto_rawDesc = "" +
 	"\x06Extend\x12%.proto.ExtendVisibilityTimeoutRequest\x1a .proto.VisibilityTimeoutResponse\x12\\\n" +
 	"\x14SetVisibilityTimeout\x12\".proto.SetVisibilityTimeoutRequest\x1a .proto.VisibilityTimeoutResponse\x12d\n" +
 	"\x18RefreshVisibilityTimeout\x12&.proto.RefreshVisibilityTimeoutRequest\x1a .proto.VisibilityTimeoutResponse\x12D\n" +
-	"\vReleaseLock\x12\x19.proto.ReleaseLockRequest\x1a\x1a.proto.ReleaseLockResponseB!Z\x1fgithub.com/kokaq/protocol/protob\x06proto3"
+	"\vReleaseLock\x12\x19.proto.ReleaseLockRequest\x1a\x1a.proto.ReleaseLockResponse\x12C\n" +
+	"\n" +
+	"GetMessage\x12\x18.proto.GetMessageRequest\x1a\x1b.proto.KokaqMessageResponse\x12C\n" +
+	"\rDeleteMessage\x12\x1b.proto.DeleteMessageRequest\x1a\x15.proto.StatusResponse\x12G\n" +
+	"\fListMessages\x12\x1a.proto.ListMessagesRequest\x1a\x1b.proto.ListMessagesResponse\x12S\n" +
+	"\x12ListLockedMessages\x12\x1a.proto.ListMessagesRequest\x1a!.proto.ListLockedMessagesResponse\x12M\n" +
+	"\x0eChangePriority\x12\x1c.proto.ChangePriorityRequest\x1a\x1d.proto.ChangePriorityResponse\x12Y\n" +
+	"\x12BulkChangePriority\x12 .proto.BulkChangePriorityRequest\x1a!.proto.BulkChangePriorityResponse\x12;\n" +
+	"\bBeginTxn\x12\x16.proto.BeginTxnRequest\x1a\x17.proto.BeginTxnResponse\x125\n" +
+	"\tCommitTxn\x12\x11.proto.TxnRequest\x1a\x15.proto.StatusResponse\x124\n" +
+	"\bAbortTxn\x12\x11.proto.TxnRequest\x1a\x15.proto.StatusResponse\x12H\n" +
+	"\rAcceptSession\x12\x1b.proto.AcceptSessionRequest\x1a\x1a.proto.SessionLockResponse\x12N\n" +
+	"\x10RenewSessionLock\x12\x1e.proto.RenewSessionLockRequest\x1a\x1a.proto.SessionLockResponse\x12P\n" +
+	"\x0fGetSessionState\x12\x1d.proto.GetSessionStateRequest\x1a\x1e.proto.GetSessionStateResponse\x12G\n" +
+	"\x0fSetSessionState\x12\x1d.proto.SetSessionStateRequest\x1a\x15.proto.StatusResponseB!Z\x1fgithub.com/kokaq/protocol/protob\x06proto3"
 
 var (
 	file_proto_data_proto_rawDescOnce sync.Once
@@ -1595,92 +3411,173 @@ func file_proto_data_proto_rawDescGZIP() []byte {
 	return file_proto_data_proto_rawDescData
 }
 
-var file_proto_data_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_proto_data_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_data_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_proto_data_proto_goTypes = []any{
-	(*KokaqMessageHeaders)(nil),             // 0: proto.KokaqMessageHeaders
-	(*KokaqMessageRequest)(nil),             // 1: proto.KokaqMessageRequest
-	(*KokaqMessageResponse)(nil),            // 2: proto.KokaqMessageResponse
-	(*EnqueueRequest)(nil),                  // 3: proto.EnqueueRequest
-	(*EnqueueResponse)(nil),                 // 4: proto.EnqueueResponse
-	(*DequeueRequest)(nil),                  // 5: proto.DequeueRequest
-	(*DequeueResponse)(nil),                 // 6: proto.DequeueResponse
-	(*PeekRequest)(nil),                     // 7: proto.PeekRequest
-	(*PeekResponse)(nil),                    // 8: proto.PeekResponse
-	(*PeekLockRequest)(nil),                 // 9: proto.PeekLockRequest
-	(*LockedMessage)(nil),                   // 10: proto.LockedMessage
-	(*PeekLockResponse)(nil),                // 11: proto.PeekLockResponse
-	(*AckRequest)(nil),                      // 12: proto.AckRequest
-	(*AckResponse)(nil),                     // 13: proto.AckResponse
-	(*NackRequest)(nil),                     // 14: proto.NackRequest
-	(*NackResponse)(nil),                    // 15: proto.NackResponse
-	(*ReleaseLockRequest)(nil),              // 16: proto.ReleaseLockRequest
-	(*ReleaseLockResponse)(nil),             // 17: proto.ReleaseLockResponse
-	(*ExtendVisibilityTimeoutRequest)(nil),  // 18: proto.ExtendVisibilityTimeoutRequest
-	(*RefreshVisibilityTimeoutRequest)(nil), // 19: proto.RefreshVisibilityTimeoutRequest
-	(*SetVisibilityTimeoutRequest)(nil),     // 20: proto.SetVisibilityTimeoutRequest
-	(*VisibilityTimeoutResponse)(nil),       // 21: proto.VisibilityTimeoutResponse
-	(*KokaqNewQueueRequest)(nil),            // 22: proto.KokaqNewQueueRequest
-	(FailureReason)(0),                      // 23: proto.FailureReason
-	(*timestamppb.Timestamp)(nil),           // 24: google.protobuf.Timestamp
-	(*KokaqQueueRequest)(nil),               // 25: proto.KokaqQueueRequest
-	(*KokaqQueueResponse)(nil),              // 26: proto.KokaqQueueResponse
-	(*KokaqStatsResponse)(nil),              // 27: proto.KokaqStatsResponse
-	(*StatusResponse)(nil),                  // 28: proto.StatusResponse
+	(PrioritySelection)(0),                  // 0: proto.PrioritySelection
+	(MessageState)(0),                       // 1: proto.MessageState
+	(MessageOrder)(0),                       // 2: proto.MessageOrder
+	(*PriorityRange)(nil),                   // 3: proto.PriorityRange
+	(*PayloadReference)(nil),                // 4: proto.PayloadReference
+	(*KokaqMessageHeaders)(nil),             // 5: proto.KokaqMessageHeaders
+	(*KokaqMessageRequest)(nil),             // 6: proto.KokaqMessageRequest
+	(*KokaqMessageResponse)(nil),            // 7: proto.KokaqMessageResponse
+	(*EnqueueRequest)(nil),                  // 8: proto.EnqueueRequest
+	(*EnqueueResponse)(nil),                 // 9: proto.EnqueueResponse
+	(*DequeueRequest)(nil),                  // 10: proto.DequeueRequest
+	(*DequeueResponse)(nil),                 // 11: proto.DequeueResponse
+	(*PeekRequest)(nil),                     // 12: proto.PeekRequest
+	(*PeekResponse)(nil),                    // 13: proto.PeekResponse
+	(*PeekLockRequest)(nil),                 // 14: proto.PeekLockRequest
+	(*LockedMessage)(nil),                   // 15: proto.LockedMessage
+	(*PeekLockResponse)(nil),                // 16: proto.PeekLockResponse
+	(*AckRequest)(nil),                      // 17: proto.AckRequest
+	(*AckResponse)(nil),                     // 18: proto.AckResponse
+	(*NackRequest)(nil),                     // 19: proto.NackRequest
+	(*NackResponse)(nil),                    // 20: proto.NackResponse
+	(*ReleaseLockRequest)(nil),              // 21: proto.ReleaseLockRequest
+	(*ReleaseLockResponse)(nil),             // 22: proto.ReleaseLockResponse
+	(*ExtendVisibilityTimeoutRequest)(nil),  // 23: proto.ExtendVisibilityTimeoutRequest
+	(*RefreshVisibilityTimeoutRequest)(nil), // 24: proto.RefreshVisibilityTimeoutRequest
+	(*SetVisibilityTimeoutRequest)(nil),     // 25: proto.SetVisibilityTimeoutRequest
+	(*VisibilityTimeoutResponse)(nil),       // 26: proto.VisibilityTimeoutResponse
+	(*GetMessageRequest)(nil),               // 27: proto.GetMessageRequest
+	(*DeleteMessageRequest)(nil),            // 28: proto.DeleteMessageRequest
+	(*ListMessagesRequest)(nil),             // 29: proto.ListMessagesRequest
+	(*ListMessagesResponse)(nil),            // 30: proto.ListMessagesResponse
+	(*ListLockedMessagesResponse)(nil),      // 31: proto.ListLockedMessagesResponse
+	(*ChangePriorityRequest)(nil),           // 32: proto.ChangePriorityRequest
+	(*ChangePriorityResponse)(nil),          // 33: proto.ChangePriorityResponse
+	(*BulkChangePriorityRequest)(nil),       // 34: proto.BulkChangePriorityRequest
+	(*BulkChangePriorityResponse)(nil),      // 35: proto.BulkChangePriorityResponse
+	(*AcceptSessionRequest)(nil),            // 36: proto.AcceptSessionRequest
+	(*RenewSessionLockRequest)(nil),         // 37: proto.RenewSessionLockRequest
+	(*SessionLockResponse)(nil),             // 38: proto.SessionLockResponse
+	(*GetSessionStateRequest)(nil),          // 39: proto.GetSessionStateRequest
+	(*GetSessionStateResponse)(nil),         // 40: proto.GetSessionStateResponse
+	(*SetSessionStateRequest)(nil),          // 41: proto.SetSessionStateRequest
+	(*BeginTxnRequest)(nil),                 // 42: proto.BeginTxnRequest
+	(*BeginTxnResponse)(nil),                // 43: proto.BeginTxnResponse
+	(*TxnRequest)(nil),                      // 44: proto.TxnRequest
+	(*KokaqNewQueueRequest)(nil),            // 45: proto.KokaqNewQueueRequest
+	nil,                                     // 46: proto.KokaqMessageHeaders.PropertiesEntry
+	(FailureReason)(0),                      // 47: proto.FailureReason
+	(*durationpb.Duration)(nil),             // 48: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),           // 49: google.protobuf.Timestamp
+	(*StatusResponse)(nil),                  // 50: proto.StatusResponse
+	(*KokaqQueueRequest)(nil),               // 51: proto.KokaqQueueRequest
+	(*WatchStatsRequest)(nil),               // 52: proto.WatchStatsRequest
+	(*KokaqQueueResponse)(nil),              // 53: proto.KokaqQueueResponse
+	(*KokaqStatsResponse)(nil),              // 54: proto.KokaqStatsResponse
 }
 var file_proto_data_proto_depIdxs = []int32{
-	23, // 0: proto.KokaqMessageHeaders.failure_reason:type_name -> proto.FailureReason
-	0,  // 1: proto.KokaqMessageRequest.headers:type_name -> proto.KokaqMessageHeaders
-	1,  // 2: proto.KokaqMessageResponse.message:type_name -> proto.KokaqMessageRequest
-	24, // 3: proto.KokaqMessageResponse.created_on:type_name -> google.protobuf.Timestamp
-	24, // 4: proto.KokaqMessageResponse.last_dequeued:type_name -> google.protobuf.Timestamp
-	24, // 5: proto.KokaqMessageResponse.expiry:type_name -> google.protobuf.Timestamp
-	24, // 6: proto.KokaqMessageResponse.dead_lettered_at:type_name -> google.protobuf.Timestamp
-	1,  // 7: proto.EnqueueRequest.message:type_name -> proto.KokaqMessageRequest
-	24, // 8: proto.EnqueueResponse.enqueued_at:type_name -> google.protobuf.Timestamp
-	2,  // 9: proto.DequeueResponse.messages:type_name -> proto.KokaqMessageResponse
-	2,  // 10: proto.PeekResponse.messages:type_name -> proto.KokaqMessageResponse
-	2,  // 11: proto.LockedMessage.message:type_name -> proto.KokaqMessageResponse
-	24, // 12: proto.LockedMessage.lock_expires_at:type_name -> google.protobuf.Timestamp
-	10, // 13: proto.PeekLockResponse.locked:type_name -> proto.LockedMessage
-	23, // 14: proto.NackRequest.failure_reason:type_name -> proto.FailureReason
-	24, // 15: proto.ReleaseLockResponse.visible_at:type_name -> google.protobuf.Timestamp
-	24, // 16: proto.VisibilityTimeoutResponse.lock_expires_at:type_name -> google.protobuf.Timestamp
-	25, // 17: proto.KokaqNewQueueRequest.request:type_name -> proto.KokaqQueueRequest
-	22, // 18: proto.KokaqDataPlane.New:input_type -> proto.KokaqNewQueueRequest
-	25, // 19: proto.KokaqDataPlane.Get:input_type -> proto.KokaqQueueRequest
-	25, // 20: proto.KokaqDataPlane.GetStats:input_type -> proto.KokaqQueueRequest
-	25, // 21: proto.KokaqDataPlane.Delete:input_type -> proto.KokaqQueueRequest
-	25, // 22: proto.KokaqDataPlane.Clear:input_type -> proto.KokaqQueueRequest
-	3,  // 23: proto.KokaqDataPlane.Enqueue:input_type -> proto.EnqueueRequest
-	5,  // 24: proto.KokaqDataPlane.Dequeue:input_type -> proto.DequeueRequest
-	7,  // 25: proto.KokaqDataPlane.Peek:input_type -> proto.PeekRequest
-	9,  // 26: proto.KokaqDataPlane.PeekLock:input_type -> proto.PeekLockRequest
-	12, // 27: proto.KokaqDataPlane.Ack:input_type -> proto.AckRequest
-	14, // 28: proto.KokaqDataPlane.Nack:input_type -> proto.NackRequest
-	18, // 29: proto.KokaqDataPlane.Extend:input_type -> proto.ExtendVisibilityTimeoutRequest
-	20, // 30: proto.KokaqDataPlane.SetVisibilityTimeout:input_type -> proto.SetVisibilityTimeoutRequest
-	19, // 31: proto.KokaqDataPlane.RefreshVisibilityTimeout:input_type -> proto.RefreshVisibilityTimeoutRequest
-	16, // 32: proto.KokaqDataPlane.ReleaseLock:input_type -> proto.ReleaseLockRequest
-	26, // 33: proto.KokaqDataPlane.New:output_type -> proto.KokaqQueueResponse
-	26, // 34: proto.KokaqDataPlane.Get:output_type -> proto.KokaqQueueResponse
-	27, // 35: proto.KokaqDataPlane.GetStats:output_type -> proto.KokaqStatsResponse
-	28, // 36: proto.KokaqDataPlane.Delete:output_type -> proto.StatusResponse
-	28, // 37: proto.KokaqDataPlane.Clear:output_type -> proto.StatusResponse
-	4,  // 38: proto.KokaqDataPlane.Enqueue:output_type -> proto.EnqueueResponse
-	6,  // 39: proto.KokaqDataPlane.Dequeue:output_type -> proto.DequeueResponse
-	8,  // 40: proto.KokaqDataPlane.Peek:output_type -> proto.PeekResponse
-	11, // 41: proto.KokaqDataPlane.PeekLock:output_type -> proto.PeekLockResponse
-	13, // 42: proto.KokaqDataPlane.Ack:output_type -> proto.AckResponse
-	15, // 43: proto.KokaqDataPlane.Nack:output_type -> proto.NackResponse
-	21, // 44: proto.KokaqDataPlane.Extend:output_type -> proto.VisibilityTimeoutResponse
-	21, // 45: proto.KokaqDataPlane.SetVisibilityTimeout:output_type -> proto.VisibilityTimeoutResponse
-	21, // 46: proto.KokaqDataPlane.RefreshVisibilityTimeout:output_type -> proto.VisibilityTimeoutResponse
-	17, // 47: proto.KokaqDataPlane.ReleaseLock:output_type -> proto.ReleaseLockResponse
-	33, // [33:48] is the sub-list for method output_type
-	18, // [18:33] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	47, // 0: proto.KokaqMessageHeaders.failure_reason:type_name -> proto.FailureReason
+	46, // 1: proto.KokaqMessageHeaders.properties:type_name -> proto.KokaqMessageHeaders.PropertiesEntry
+	5,  // 2: proto.KokaqMessageRequest.headers:type_name -> proto.KokaqMessageHeaders
+	48, // 3: proto.KokaqMessageRequest.time_to_live:type_name -> google.protobuf.Duration
+	4,  // 4: proto.KokaqMessageRequest.payload_ref:type_name -> proto.PayloadReference
+	6,  // 5: proto.KokaqMessageResponse.message:type_name -> proto.KokaqMessageRequest
+	49, // 6: proto.KokaqMessageResponse.created_on:type_name -> google.protobuf.Timestamp
+	49, // 7: proto.KokaqMessageResponse.last_dequeued:type_name -> google.protobuf.Timestamp
+	49, // 8: proto.KokaqMessageResponse.expiry:type_name -> google.protobuf.Timestamp
+	49, // 9: proto.KokaqMessageResponse.dead_lettered_at:type_name -> google.protobuf.Timestamp
+	48, // 10: proto.KokaqMessageResponse.lock_timeout:type_name -> google.protobuf.Duration
+	1,  // 11: proto.KokaqMessageResponse.state:type_name -> proto.MessageState
+	6,  // 12: proto.EnqueueRequest.message:type_name -> proto.KokaqMessageRequest
+	49, // 13: proto.EnqueueResponse.enqueued_at:type_name -> google.protobuf.Timestamp
+	3,  // 14: proto.DequeueRequest.priority_range:type_name -> proto.PriorityRange
+	0,  // 15: proto.DequeueRequest.priority_selection:type_name -> proto.PrioritySelection
+	48, // 16: proto.DequeueRequest.wait_time:type_name -> google.protobuf.Duration
+	7,  // 17: proto.DequeueResponse.messages:type_name -> proto.KokaqMessageResponse
+	7,  // 18: proto.PeekResponse.messages:type_name -> proto.KokaqMessageResponse
+	3,  // 19: proto.PeekLockRequest.priority_range:type_name -> proto.PriorityRange
+	0,  // 20: proto.PeekLockRequest.priority_selection:type_name -> proto.PrioritySelection
+	48, // 21: proto.PeekLockRequest.lock_timeout:type_name -> google.protobuf.Duration
+	48, // 22: proto.PeekLockRequest.wait_time:type_name -> google.protobuf.Duration
+	7,  // 23: proto.LockedMessage.message:type_name -> proto.KokaqMessageResponse
+	49, // 24: proto.LockedMessage.lock_expires_at:type_name -> google.protobuf.Timestamp
+	15, // 25: proto.PeekLockResponse.locked:type_name -> proto.LockedMessage
+	47, // 26: proto.NackRequest.failure_reason:type_name -> proto.FailureReason
+	48, // 27: proto.NackRequest.redeliver_after:type_name -> google.protobuf.Duration
+	49, // 28: proto.NackResponse.visible_at:type_name -> google.protobuf.Timestamp
+	49, // 29: proto.ReleaseLockResponse.visible_at:type_name -> google.protobuf.Timestamp
+	48, // 30: proto.ExtendVisibilityTimeoutRequest.extend_by:type_name -> google.protobuf.Duration
+	48, // 31: proto.SetVisibilityTimeoutRequest.new_timeout:type_name -> google.protobuf.Duration
+	49, // 32: proto.VisibilityTimeoutResponse.lock_expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 33: proto.ListMessagesRequest.order:type_name -> proto.MessageOrder
+	7,  // 34: proto.ListMessagesResponse.messages:type_name -> proto.KokaqMessageResponse
+	15, // 35: proto.ListLockedMessagesResponse.locked:type_name -> proto.LockedMessage
+	50, // 36: proto.ChangePriorityResponse.status:type_name -> proto.StatusResponse
+	50, // 37: proto.BulkChangePriorityResponse.status:type_name -> proto.StatusResponse
+	48, // 38: proto.AcceptSessionRequest.lock_duration:type_name -> google.protobuf.Duration
+	49, // 39: proto.SessionLockResponse.lock_expires_at:type_name -> google.protobuf.Timestamp
+	50, // 40: proto.GetSessionStateResponse.status:type_name -> proto.StatusResponse
+	48, // 41: proto.BeginTxnRequest.timeout:type_name -> google.protobuf.Duration
+	49, // 42: proto.BeginTxnResponse.expires_at:type_name -> google.protobuf.Timestamp
+	51, // 43: proto.KokaqNewQueueRequest.request:type_name -> proto.KokaqQueueRequest
+	45, // 44: proto.KokaqDataPlane.New:input_type -> proto.KokaqNewQueueRequest
+	51, // 45: proto.KokaqDataPlane.Get:input_type -> proto.KokaqQueueRequest
+	51, // 46: proto.KokaqDataPlane.GetStats:input_type -> proto.KokaqQueueRequest
+	52, // 47: proto.KokaqDataPlane.WatchStats:input_type -> proto.WatchStatsRequest
+	51, // 48: proto.KokaqDataPlane.Delete:input_type -> proto.KokaqQueueRequest
+	51, // 49: proto.KokaqDataPlane.Clear:input_type -> proto.KokaqQueueRequest
+	8,  // 50: proto.KokaqDataPlane.Enqueue:input_type -> proto.EnqueueRequest
+	10, // 51: proto.KokaqDataPlane.Dequeue:input_type -> proto.DequeueRequest
+	12, // 52: proto.KokaqDataPlane.Peek:input_type -> proto.PeekRequest
+	14, // 53: proto.KokaqDataPlane.PeekLock:input_type -> proto.PeekLockRequest
+	17, // 54: proto.KokaqDataPlane.Ack:input_type -> proto.AckRequest
+	19, // 55: proto.KokaqDataPlane.Nack:input_type -> proto.NackRequest
+	23, // 56: proto.KokaqDataPlane.Extend:input_type -> proto.ExtendVisibilityTimeoutRequest
+	25, // 57: proto.KokaqDataPlane.SetVisibilityTimeout:input_type -> proto.SetVisibilityTimeoutRequest
+	24, // 58: proto.KokaqDataPlane.RefreshVisibilityTimeout:input_type -> proto.RefreshVisibilityTimeoutRequest
+	21, // 59: proto.KokaqDataPlane.ReleaseLock:input_type -> proto.ReleaseLockRequest
+	27, // 60: proto.KokaqDataPlane.GetMessage:input_type -> proto.GetMessageRequest
+	28, // 61: proto.KokaqDataPlane.DeleteMessage:input_type -> proto.DeleteMessageRequest
+	29, // 62: proto.KokaqDataPlane.ListMessages:input_type -> proto.ListMessagesRequest
+	29, // 63: proto.KokaqDataPlane.ListLockedMessages:input_type -> proto.ListMessagesRequest
+	32, // 64: proto.KokaqDataPlane.ChangePriority:input_type -> proto.ChangePriorityRequest
+	34, // 65: proto.KokaqDataPlane.BulkChangePriority:input_type -> proto.BulkChangePriorityRequest
+	42, // 66: proto.KokaqDataPlane.BeginTxn:input_type -> proto.BeginTxnRequest
+	44, // 67: proto.KokaqDataPlane.CommitTxn:input_type -> proto.TxnRequest
+	44, // 68: proto.KokaqDataPlane.AbortTxn:input_type -> proto.TxnRequest
+	36, // 69: proto.KokaqDataPlane.AcceptSession:input_type -> proto.AcceptSessionRequest
+	37, // 70: proto.KokaqDataPlane.RenewSessionLock:input_type -> proto.RenewSessionLockRequest
+	39, // 71: proto.KokaqDataPlane.GetSessionState:input_type -> proto.GetSessionStateRequest
+	41, // 72: proto.KokaqDataPlane.SetSessionState:input_type -> proto.SetSessionStateRequest
+	53, // 73: proto.KokaqDataPlane.New:output_type -> proto.KokaqQueueResponse
+	53, // 74: proto.KokaqDataPlane.Get:output_type -> proto.KokaqQueueResponse
+	54, // 75: proto.KokaqDataPlane.GetStats:output_type -> proto.KokaqStatsResponse
+	54, // 76: proto.KokaqDataPlane.WatchStats:output_type -> proto.KokaqStatsResponse
+	50, // 77: proto.KokaqDataPlane.Delete:output_type -> proto.StatusResponse
+	50, // 78: proto.KokaqDataPlane.Clear:output_type -> proto.StatusResponse
+	9,  // 79: proto.KokaqDataPlane.Enqueue:output_type -> proto.EnqueueResponse
+	11, // 80: proto.KokaqDataPlane.Dequeue:output_type -> proto.DequeueResponse
+	13, // 81: proto.KokaqDataPlane.Peek:output_type -> proto.PeekResponse
+	16, // 82: proto.KokaqDataPlane.PeekLock:output_type -> proto.PeekLockResponse
+	18, // 83: proto.KokaqDataPlane.Ack:output_type -> proto.AckResponse
+	20, // 84: proto.KokaqDataPlane.Nack:output_type -> proto.NackResponse
+	26, // 85: proto.KokaqDataPlane.Extend:output_type -> proto.VisibilityTimeoutResponse
+	26, // 86: proto.KokaqDataPlane.SetVisibilityTimeout:output_type -> proto.VisibilityTimeoutResponse
+	26, // 87: proto.KokaqDataPlane.RefreshVisibilityTimeout:output_type -> proto.VisibilityTimeoutResponse
+	22, // 88: proto.KokaqDataPlane.ReleaseLock:output_type -> proto.ReleaseLockResponse
+	7,  // 89: proto.KokaqDataPlane.GetMessage:output_type -> proto.KokaqMessageResponse
+	50, // 90: proto.KokaqDataPlane.DeleteMessage:output_type -> proto.StatusResponse
+	30, // 91: proto.KokaqDataPlane.ListMessages:output_type -> proto.ListMessagesResponse
+	31, // 92: proto.KokaqDataPlane.ListLockedMessages:output_type -> proto.ListLockedMessagesResponse
+	33, // 93: proto.KokaqDataPlane.ChangePriority:output_type -> proto.ChangePriorityResponse
+	35, // 94: proto.KokaqDataPlane.BulkChangePriority:output_type -> proto.BulkChangePriorityResponse
+	43, // 95: proto.KokaqDataPlane.BeginTxn:output_type -> proto.BeginTxnResponse
+	50, // 96: proto.KokaqDataPlane.CommitTxn:output_type -> proto.StatusResponse
+	50, // 97: proto.KokaqDataPlane.AbortTxn:output_type -> proto.StatusResponse
+	38, // 98: proto.KokaqDataPlane.AcceptSession:output_type -> proto.SessionLockResponse
+	38, // 99: proto.KokaqDataPlane.RenewSessionLock:output_type -> proto.SessionLockResponse
+	40, // 100: proto.KokaqDataPlane.GetSessionState:output_type -> proto.GetSessionStateResponse
+	50, // 101: proto.KokaqDataPlane.SetSessionState:output_type -> proto.StatusResponse
+	73, // [73:102] is the sub-list for method output_type
+	44, // [44:73] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_proto_data_proto_init() }
@@ -1689,18 +3586,20 @@ func file_proto_data_proto_init() {
 		return
 	}
 	file_proto_common_proto_init()
+	file_proto_data_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_data_proto_rawDesc), len(file_proto_data_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   23,
+			NumEnums:      3,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_data_proto_goTypes,
 		DependencyIndexes: file_proto_data_proto_depIdxs,
+		EnumInfos:         file_proto_data_proto_enumTypes,
 		MessageInfos:      file_proto_data_proto_msgTypes,
 	}.Build()
 	File_proto_data_proto = out.File
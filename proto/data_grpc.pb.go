@@ -22,6 +22,7 @@ const (
 	KokaqDataPlane_New_FullMethodName                      = "/proto.KokaqDataPlane/New"
 	KokaqDataPlane_Get_FullMethodName                      = "/proto.KokaqDataPlane/Get"
 	KokaqDataPlane_GetStats_FullMethodName                 = "/proto.KokaqDataPlane/GetStats"
+	KokaqDataPlane_WatchStats_FullMethodName               = "/proto.KokaqDataPlane/WatchStats"
 	KokaqDataPlane_Delete_FullMethodName                   = "/proto.KokaqDataPlane/Delete"
 	KokaqDataPlane_Clear_FullMethodName                    = "/proto.KokaqDataPlane/Clear"
 	KokaqDataPlane_Enqueue_FullMethodName                  = "/proto.KokaqDataPlane/Enqueue"
@@ -34,6 +35,19 @@ const (
 	KokaqDataPlane_SetVisibilityTimeout_FullMethodName     = "/proto.KokaqDataPlane/SetVisibilityTimeout"
 	KokaqDataPlane_RefreshVisibilityTimeout_FullMethodName = "/proto.KokaqDataPlane/RefreshVisibilityTimeout"
 	KokaqDataPlane_ReleaseLock_FullMethodName              = "/proto.KokaqDataPlane/ReleaseLock"
+	KokaqDataPlane_GetMessage_FullMethodName               = "/proto.KokaqDataPlane/GetMessage"
+	KokaqDataPlane_DeleteMessage_FullMethodName            = "/proto.KokaqDataPlane/DeleteMessage"
+	KokaqDataPlane_ListMessages_FullMethodName             = "/proto.KokaqDataPlane/ListMessages"
+	KokaqDataPlane_ListLockedMessages_FullMethodName       = "/proto.KokaqDataPlane/ListLockedMessages"
+	KokaqDataPlane_ChangePriority_FullMethodName           = "/proto.KokaqDataPlane/ChangePriority"
+	KokaqDataPlane_BulkChangePriority_FullMethodName       = "/proto.KokaqDataPlane/BulkChangePriority"
+	KokaqDataPlane_BeginTxn_FullMethodName                 = "/proto.KokaqDataPlane/BeginTxn"
+	KokaqDataPlane_CommitTxn_FullMethodName                = "/proto.KokaqDataPlane/CommitTxn"
+	KokaqDataPlane_AbortTxn_FullMethodName                 = "/proto.KokaqDataPlane/AbortTxn"
+	KokaqDataPlane_AcceptSession_FullMethodName            = "/proto.KokaqDataPlane/AcceptSession"
+	KokaqDataPlane_RenewSessionLock_FullMethodName         = "/proto.KokaqDataPlane/RenewSessionLock"
+	KokaqDataPlane_GetSessionState_FullMethodName          = "/proto.KokaqDataPlane/GetSessionState"
+	KokaqDataPlane_SetSessionState_FullMethodName          = "/proto.KokaqDataPlane/SetSessionState"
 )
 
 // KokaqDataPlaneClient is the client API for KokaqDataPlane service.
@@ -45,6 +59,7 @@ type KokaqDataPlaneClient interface {
 	New(ctx context.Context, in *KokaqNewQueueRequest, opts ...grpc.CallOption) (*KokaqQueueResponse, error)
 	Get(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*KokaqQueueResponse, error)
 	GetStats(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*KokaqStatsResponse, error)
+	WatchStats(ctx context.Context, in *WatchStatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KokaqStatsResponse], error)
 	Delete(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	Clear(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	Enqueue(ctx context.Context, in *EnqueueRequest, opts ...grpc.CallOption) (*EnqueueResponse, error)
@@ -57,6 +72,19 @@ type KokaqDataPlaneClient interface {
 	SetVisibilityTimeout(ctx context.Context, in *SetVisibilityTimeoutRequest, opts ...grpc.CallOption) (*VisibilityTimeoutResponse, error)
 	RefreshVisibilityTimeout(ctx context.Context, in *RefreshVisibilityTimeoutRequest, opts ...grpc.CallOption) (*VisibilityTimeoutResponse, error)
 	ReleaseLock(ctx context.Context, in *ReleaseLockRequest, opts ...grpc.CallOption) (*ReleaseLockResponse, error)
+	GetMessage(ctx context.Context, in *GetMessageRequest, opts ...grpc.CallOption) (*KokaqMessageResponse, error)
+	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error)
+	ListLockedMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListLockedMessagesResponse, error)
+	ChangePriority(ctx context.Context, in *ChangePriorityRequest, opts ...grpc.CallOption) (*ChangePriorityResponse, error)
+	BulkChangePriority(ctx context.Context, in *BulkChangePriorityRequest, opts ...grpc.CallOption) (*BulkChangePriorityResponse, error)
+	BeginTxn(ctx context.Context, in *BeginTxnRequest, opts ...grpc.CallOption) (*BeginTxnResponse, error)
+	CommitTxn(ctx context.Context, in *TxnRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	AbortTxn(ctx context.Context, in *TxnRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	AcceptSession(ctx context.Context, in *AcceptSessionRequest, opts ...grpc.CallOption) (*SessionLockResponse, error)
+	RenewSessionLock(ctx context.Context, in *RenewSessionLockRequest, opts ...grpc.CallOption) (*SessionLockResponse, error)
+	GetSessionState(ctx context.Context, in *GetSessionStateRequest, opts ...grpc.CallOption) (*GetSessionStateResponse, error)
+	SetSessionState(ctx context.Context, in *SetSessionStateRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 }
 
 type kokaqDataPlaneClient struct {
@@ -97,6 +125,25 @@ func (c *kokaqDataPlaneClient) GetStats(ctx context.Context, in *KokaqQueueReque
 	return out, nil
 }
 
+func (c *kokaqDataPlaneClient) WatchStats(ctx context.Context, in *WatchStatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KokaqStatsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &KokaqDataPlane_ServiceDesc.Streams[0], KokaqDataPlane_WatchStats_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchStatsRequest, KokaqStatsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KokaqDataPlane_WatchStatsClient = grpc.ServerStreamingClient[KokaqStatsResponse]
+
 func (c *kokaqDataPlaneClient) Delete(ctx context.Context, in *KokaqQueueRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StatusResponse)
@@ -217,6 +264,136 @@ func (c *kokaqDataPlaneClient) ReleaseLock(ctx context.Context, in *ReleaseLockR
 	return out, nil
 }
 
+func (c *kokaqDataPlaneClient) GetMessage(ctx context.Context, in *GetMessageRequest, opts ...grpc.CallOption) (*KokaqMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KokaqMessageResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_GetMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_DeleteMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMessagesResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_ListMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) ListLockedMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListLockedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLockedMessagesResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_ListLockedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) ChangePriority(ctx context.Context, in *ChangePriorityRequest, opts ...grpc.CallOption) (*ChangePriorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePriorityResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_ChangePriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) BulkChangePriority(ctx context.Context, in *BulkChangePriorityRequest, opts ...grpc.CallOption) (*BulkChangePriorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkChangePriorityResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_BulkChangePriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) BeginTxn(ctx context.Context, in *BeginTxnRequest, opts ...grpc.CallOption) (*BeginTxnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginTxnResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_BeginTxn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) CommitTxn(ctx context.Context, in *TxnRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_CommitTxn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) AbortTxn(ctx context.Context, in *TxnRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_AbortTxn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) AcceptSession(ctx context.Context, in *AcceptSessionRequest, opts ...grpc.CallOption) (*SessionLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionLockResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_AcceptSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) RenewSessionLock(ctx context.Context, in *RenewSessionLockRequest, opts ...grpc.CallOption) (*SessionLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionLockResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_RenewSessionLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) GetSessionState(ctx context.Context, in *GetSessionStateRequest, opts ...grpc.CallOption) (*GetSessionStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionStateResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_GetSessionState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kokaqDataPlaneClient) SetSessionState(ctx context.Context, in *SetSessionStateRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, KokaqDataPlane_SetSessionState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KokaqDataPlaneServer is the server API for KokaqDataPlane service.
 // All implementations must embed UnimplementedKokaqDataPlaneServer
 // for forward compatibility.
@@ -226,6 +403,7 @@ type KokaqDataPlaneServer interface {
 	New(context.Context, *KokaqNewQueueRequest) (*KokaqQueueResponse, error)
 	Get(context.Context, *KokaqQueueRequest) (*KokaqQueueResponse, error)
 	GetStats(context.Context, *KokaqQueueRequest) (*KokaqStatsResponse, error)
+	WatchStats(*WatchStatsRequest, grpc.ServerStreamingServer[KokaqStatsResponse]) error
 	Delete(context.Context, *KokaqQueueRequest) (*StatusResponse, error)
 	Clear(context.Context, *KokaqQueueRequest) (*StatusResponse, error)
 	Enqueue(context.Context, *EnqueueRequest) (*EnqueueResponse, error)
@@ -238,6 +416,19 @@ type KokaqDataPlaneServer interface {
 	SetVisibilityTimeout(context.Context, *SetVisibilityTimeoutRequest) (*VisibilityTimeoutResponse, error)
 	RefreshVisibilityTimeout(context.Context, *RefreshVisibilityTimeoutRequest) (*VisibilityTimeoutResponse, error)
 	ReleaseLock(context.Context, *ReleaseLockRequest) (*ReleaseLockResponse, error)
+	GetMessage(context.Context, *GetMessageRequest) (*KokaqMessageResponse, error)
+	DeleteMessage(context.Context, *DeleteMessageRequest) (*StatusResponse, error)
+	ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error)
+	ListLockedMessages(context.Context, *ListMessagesRequest) (*ListLockedMessagesResponse, error)
+	ChangePriority(context.Context, *ChangePriorityRequest) (*ChangePriorityResponse, error)
+	BulkChangePriority(context.Context, *BulkChangePriorityRequest) (*BulkChangePriorityResponse, error)
+	BeginTxn(context.Context, *BeginTxnRequest) (*BeginTxnResponse, error)
+	CommitTxn(context.Context, *TxnRequest) (*StatusResponse, error)
+	AbortTxn(context.Context, *TxnRequest) (*StatusResponse, error)
+	AcceptSession(context.Context, *AcceptSessionRequest) (*SessionLockResponse, error)
+	RenewSessionLock(context.Context, *RenewSessionLockRequest) (*SessionLockResponse, error)
+	GetSessionState(context.Context, *GetSessionStateRequest) (*GetSessionStateResponse, error)
+	SetSessionState(context.Context, *SetSessionStateRequest) (*StatusResponse, error)
 	mustEmbedUnimplementedKokaqDataPlaneServer()
 }
 
@@ -257,6 +448,9 @@ func (UnimplementedKokaqDataPlaneServer) Get(context.Context, *KokaqQueueRequest
 func (UnimplementedKokaqDataPlaneServer) GetStats(context.Context, *KokaqQueueRequest) (*KokaqStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
 }
+func (UnimplementedKokaqDataPlaneServer) WatchStats(*WatchStatsRequest, grpc.ServerStreamingServer[KokaqStatsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStats not implemented")
+}
 func (UnimplementedKokaqDataPlaneServer) Delete(context.Context, *KokaqQueueRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -293,6 +487,45 @@ func (UnimplementedKokaqDataPlaneServer) RefreshVisibilityTimeout(context.Contex
 func (UnimplementedKokaqDataPlaneServer) ReleaseLock(context.Context, *ReleaseLockRequest) (*ReleaseLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLock not implemented")
 }
+func (UnimplementedKokaqDataPlaneServer) GetMessage(context.Context, *GetMessageRequest) (*KokaqMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessage not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessages not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) ListLockedMessages(context.Context, *ListMessagesRequest) (*ListLockedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLockedMessages not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) ChangePriority(context.Context, *ChangePriorityRequest) (*ChangePriorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePriority not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) BulkChangePriority(context.Context, *BulkChangePriorityRequest) (*BulkChangePriorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkChangePriority not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) BeginTxn(context.Context, *BeginTxnRequest) (*BeginTxnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTxn not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) CommitTxn(context.Context, *TxnRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitTxn not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) AbortTxn(context.Context, *TxnRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortTxn not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) AcceptSession(context.Context, *AcceptSessionRequest) (*SessionLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSession not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) RenewSessionLock(context.Context, *RenewSessionLockRequest) (*SessionLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewSessionLock not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) GetSessionState(context.Context, *GetSessionStateRequest) (*GetSessionStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionState not implemented")
+}
+func (UnimplementedKokaqDataPlaneServer) SetSessionState(context.Context, *SetSessionStateRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSessionState not implemented")
+}
 func (UnimplementedKokaqDataPlaneServer) mustEmbedUnimplementedKokaqDataPlaneServer() {}
 func (UnimplementedKokaqDataPlaneServer) testEmbeddedByValue()                        {}
 
@@ -368,6 +601,17 @@ func _KokaqDataPlane_GetStats_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KokaqDataPlane_WatchStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KokaqDataPlaneServer).WatchStats(m, &grpc.GenericServerStream[WatchStatsRequest, KokaqStatsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KokaqDataPlane_WatchStatsServer = grpc.ServerStreamingServer[KokaqStatsResponse]
+
 func _KokaqDataPlane_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KokaqQueueRequest)
 	if err := dec(in); err != nil {
@@ -584,6 +828,240 @@ func _KokaqDataPlane_ReleaseLock_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KokaqDataPlane_GetMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).GetMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_GetMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).GetMessage(ctx, req.(*GetMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_DeleteMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_ListMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).ListMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_ListMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).ListMessages(ctx, req.(*ListMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_ListLockedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).ListLockedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_ListLockedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).ListLockedMessages(ctx, req.(*ListMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_ChangePriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).ChangePriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_ChangePriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).ChangePriority(ctx, req.(*ChangePriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_BulkChangePriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkChangePriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).BulkChangePriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_BulkChangePriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).BulkChangePriority(ctx, req.(*BulkChangePriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_BeginTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginTxnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).BeginTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_BeginTxn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).BeginTxn(ctx, req.(*BeginTxnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_CommitTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).CommitTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_CommitTxn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).CommitTxn(ctx, req.(*TxnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_AbortTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).AbortTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_AbortTxn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).AbortTxn(ctx, req.(*TxnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_AcceptSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).AcceptSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_AcceptSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).AcceptSession(ctx, req.(*AcceptSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_RenewSessionLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewSessionLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).RenewSessionLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_RenewSessionLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).RenewSessionLock(ctx, req.(*RenewSessionLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_GetSessionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).GetSessionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_GetSessionState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).GetSessionState(ctx, req.(*GetSessionStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KokaqDataPlane_SetSessionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSessionStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KokaqDataPlaneServer).SetSessionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KokaqDataPlane_SetSessionState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KokaqDataPlaneServer).SetSessionState(ctx, req.(*SetSessionStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KokaqDataPlane_ServiceDesc is the grpc.ServiceDesc for KokaqDataPlane service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -651,7 +1129,65 @@ var KokaqDataPlane_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseLock",
 			Handler:    _KokaqDataPlane_ReleaseLock_Handler,
 		},
+		{
+			MethodName: "GetMessage",
+			Handler:    _KokaqDataPlane_GetMessage_Handler,
+		},
+		{
+			MethodName: "DeleteMessage",
+			Handler:    _KokaqDataPlane_DeleteMessage_Handler,
+		},
+		{
+			MethodName: "ListMessages",
+			Handler:    _KokaqDataPlane_ListMessages_Handler,
+		},
+		{
+			MethodName: "ListLockedMessages",
+			Handler:    _KokaqDataPlane_ListLockedMessages_Handler,
+		},
+		{
+			MethodName: "ChangePriority",
+			Handler:    _KokaqDataPlane_ChangePriority_Handler,
+		},
+		{
+			MethodName: "BulkChangePriority",
+			Handler:    _KokaqDataPlane_BulkChangePriority_Handler,
+		},
+		{
+			MethodName: "BeginTxn",
+			Handler:    _KokaqDataPlane_BeginTxn_Handler,
+		},
+		{
+			MethodName: "CommitTxn",
+			Handler:    _KokaqDataPlane_CommitTxn_Handler,
+		},
+		{
+			MethodName: "AbortTxn",
+			Handler:    _KokaqDataPlane_AbortTxn_Handler,
+		},
+		{
+			MethodName: "AcceptSession",
+			Handler:    _KokaqDataPlane_AcceptSession_Handler,
+		},
+		{
+			MethodName: "RenewSessionLock",
+			Handler:    _KokaqDataPlane_RenewSessionLock_Handler,
+		},
+		{
+			MethodName: "GetSessionState",
+			Handler:    _KokaqDataPlane_GetSessionState_Handler,
+		},
+		{
+			MethodName: "SetSessionState",
+			Handler:    _KokaqDataPlane_SetSessionState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStats",
+			Handler:       _KokaqDataPlane_WatchStats_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/data.proto",
 }
@@ -0,0 +1,91 @@
+package proto
+
+import (
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Lock and visibility durations are carried twice: as a
+// google.protobuf.Duration and as a deprecated integer in seconds or
+// milliseconds. The readers below prefer the Duration and fall back to the
+// integer; the setters fill both so older servers keep working.
+
+// LockTimeout returns the lock duration asked for by r, or zero if unset.
+func LockTimeout(r *PeekLockRequest) time.Duration {
+	if d := r.GetLockTimeout(); d != nil {
+		return d.AsDuration()
+	}
+	return time.Duration(r.GetLockDuration()) * time.Second
+}
+
+// SetLockTimeout sets the lock duration asked for by r.
+func SetLockTimeout(r *PeekLockRequest, d time.Duration) {
+	r.LockTimeout = durationpb.New(d)
+	r.LockDuration = ceilUnits(d, time.Second)
+}
+
+// ExtendBy returns how far r pushes the lock expiry out, or zero if unset.
+func ExtendBy(r *ExtendVisibilityTimeoutRequest) time.Duration {
+	if d := r.GetExtendBy(); d != nil {
+		return d.AsDuration()
+	}
+	return time.Duration(r.GetAdditionalMs()) * time.Millisecond
+}
+
+// SetExtendBy sets how far r pushes the lock expiry out.
+func SetExtendBy(r *ExtendVisibilityTimeoutRequest, d time.Duration) {
+	r.ExtendBy = durationpb.New(d)
+	r.AdditionalMs = ceilUnits(d, time.Millisecond)
+}
+
+// NewTimeout returns the lock duration r replaces the current one with, or
+// zero if unset.
+func NewTimeout(r *SetVisibilityTimeoutRequest) time.Duration {
+	if d := r.GetNewTimeout(); d != nil {
+		return d.AsDuration()
+	}
+	return time.Duration(r.GetNewTimeoutMs()) * time.Millisecond
+}
+
+// SetNewTimeout sets the lock duration r replaces the current one with.
+func SetNewTimeout(r *SetVisibilityTimeoutRequest, d time.Duration) {
+	r.NewTimeout = durationpb.New(d)
+	r.NewTimeoutMs = ceilUnits(d, time.Millisecond)
+}
+
+// DefaultLockTimeout returns the lock duration of q, or zero if unset.
+func DefaultLockTimeout(q *KokaqQueueRequest) time.Duration {
+	if d := q.GetDefaultLockTimeout(); d != nil {
+		return d.AsDuration()
+	}
+	return time.Duration(q.GetDefaultVisibilityTimeout()) * time.Second
+}
+
+// SetDefaultLockTimeout sets the lock duration of q.
+func SetDefaultLockTimeout(q *KokaqQueueRequest, d time.Duration) {
+	q.DefaultLockTimeout = durationpb.New(d)
+	q.DefaultVisibilityTimeout = ceilUnits(d, time.Second)
+}
+
+// MessageLockTimeout returns the lock duration reported for m, or zero if unset.
+func MessageLockTimeout(m *KokaqMessageResponse) time.Duration {
+	if d := m.GetLockTimeout(); d != nil {
+		return d.AsDuration()
+	}
+	return time.Duration(m.GetVisibilityTimeout()) * time.Second
+}
+
+// ceilUnits expresses d in whole units, rounding up so a lock is never
+// shorter than asked for.
+func ceilUnits(d, unit time.Duration) uint32 {
+	if d <= 0 {
+		return 0
+	}
+	n := (d + unit - 1) / unit
+	if n > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(n)
+}
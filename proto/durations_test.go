@@ -0,0 +1,130 @@
+package proto
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// durationPair is a Duration field and its deprecated integer twin.
+type durationPair struct {
+	name string
+	unit time.Duration
+	// get reads the pair with only the Duration, only the integer, or both
+	// set; nil arguments leave a field unset.
+	get func(d *durationpb.Duration, legacy uint32) time.Duration
+	// set calls the setter and returns the integer it stored; nil for
+	// read-only pairs.
+	set func(d time.Duration) (time.Duration, uint32)
+}
+
+var durationPairs = []durationPair{
+	{
+		name: "PeekLockRequest.lock_timeout",
+		unit: time.Second,
+		get: func(d *durationpb.Duration, legacy uint32) time.Duration {
+			return LockTimeout(&PeekLockRequest{LockTimeout: d, LockDuration: legacy})
+		},
+		set: func(d time.Duration) (time.Duration, uint32) {
+			r := &PeekLockRequest{}
+			SetLockTimeout(r, d)
+			return r.GetLockTimeout().AsDuration(), r.GetLockDuration()
+		},
+	},
+	{
+		name: "ExtendVisibilityTimeoutRequest.extend_by",
+		unit: time.Millisecond,
+		get: func(d *durationpb.Duration, legacy uint32) time.Duration {
+			return ExtendBy(&ExtendVisibilityTimeoutRequest{ExtendBy: d, AdditionalMs: legacy})
+		},
+		set: func(d time.Duration) (time.Duration, uint32) {
+			r := &ExtendVisibilityTimeoutRequest{}
+			SetExtendBy(r, d)
+			return r.GetExtendBy().AsDuration(), r.GetAdditionalMs()
+		},
+	},
+	{
+		name: "SetVisibilityTimeoutRequest.new_timeout",
+		unit: time.Millisecond,
+		get: func(d *durationpb.Duration, legacy uint32) time.Duration {
+			return NewTimeout(&SetVisibilityTimeoutRequest{NewTimeout: d, NewTimeoutMs: legacy})
+		},
+		set: func(d time.Duration) (time.Duration, uint32) {
+			r := &SetVisibilityTimeoutRequest{}
+			SetNewTimeout(r, d)
+			return r.GetNewTimeout().AsDuration(), r.GetNewTimeoutMs()
+		},
+	},
+	{
+		name: "KokaqQueueRequest.default_lock_timeout",
+		unit: time.Second,
+		get: func(d *durationpb.Duration, legacy uint32) time.Duration {
+			return DefaultLockTimeout(&KokaqQueueRequest{DefaultLockTimeout: d, DefaultVisibilityTimeout: legacy})
+		},
+		set: func(d time.Duration) (time.Duration, uint32) {
+			q := &KokaqQueueRequest{}
+			SetDefaultLockTimeout(q, d)
+			return q.GetDefaultLockTimeout().AsDuration(), q.GetDefaultVisibilityTimeout()
+		},
+	},
+	{
+		name: "KokaqMessageResponse.lock_timeout",
+		unit: time.Second,
+		get: func(d *durationpb.Duration, legacy uint32) time.Duration {
+			return MessageLockTimeout(&KokaqMessageResponse{LockTimeout: d, VisibilityTimeout: legacy})
+		},
+	},
+}
+
+func TestDurationReaders(t *testing.T) {
+	for _, p := range durationPairs {
+		for _, c := range []struct {
+			name   string
+			d      *durationpb.Duration
+			legacy uint32
+			want   time.Duration
+		}{
+			{"unset", nil, 0, 0},
+			{"legacy only", nil, 7, 7 * p.unit},
+			{"duration only", durationpb.New(1500 * time.Millisecond), 0, 1500 * time.Millisecond},
+			{"duration wins", durationpb.New(1500 * time.Millisecond), 7, 1500 * time.Millisecond},
+			{"explicit zero wins", durationpb.New(0), 7, 0},
+		} {
+			if got := p.get(c.d, c.legacy); got != c.want {
+				t.Errorf("%s, %s: got %v, want %v", p.name, c.name, got, c.want)
+			}
+		}
+	}
+}
+
+func TestDurationSetters(t *testing.T) {
+	for _, p := range durationPairs {
+		if p.set == nil {
+			continue
+		}
+		for _, c := range []struct {
+			d      time.Duration
+			legacy uint32
+		}{
+			{0, 0},
+			{-time.Second, 0},
+			{time.Nanosecond, 1},
+			{p.unit, 1},
+			{p.unit + 1, 2},
+			{5 * p.unit, 5},
+			{time.Duration(math.MaxUint32+1) * p.unit, math.MaxUint32},
+		} {
+			d, legacy := p.set(c.d)
+			if d != c.d || legacy != c.legacy {
+				t.Errorf("%s: set(%v) stored %v and %d, want %v and %d", p.name, c.d, d, legacy, c.d, c.legacy)
+			}
+			// Short of saturating, an older reader sees the duration rounded
+			// up, never shorter.
+			if back := p.get(nil, legacy); c.d > 0 && legacy < math.MaxUint32 && back < c.d {
+				t.Errorf("%s: legacy field of set(%v) reads back as %v", p.name, c.d, back)
+			}
+		}
+	}
+}
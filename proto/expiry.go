@@ -0,0 +1,29 @@
+package proto
+
+import "time"
+
+// TimeToLive resolves the time to live of msg on queue. A message level
+// time_to_live wins over the queue default_time_to_live, which in turn wins
+// over the deprecated default_expiry. Zero means the message never expires.
+func TimeToLive(queue *KokaqQueueRequest, msg *KokaqMessageRequest) time.Duration {
+	if ttl := msg.GetTimeToLive(); ttl != nil {
+		return ttl.AsDuration()
+	}
+	if ttl := queue.GetDefaultTimeToLive(); ttl != nil {
+		return ttl.AsDuration()
+	}
+	if legacy := queue.GetDefaultExpiry(); legacy != nil {
+		return time.Duration(legacy.GetSeconds())*time.Second + time.Duration(legacy.GetNanos())
+	}
+	return 0
+}
+
+// ExpiresAt returns the instant a message enqueued at enqueuedAt expires, or
+// the zero time if it never does.
+func ExpiresAt(queue *KokaqQueueRequest, msg *KokaqMessageRequest, enqueuedAt time.Time) time.Time {
+	ttl := TimeToLive(queue, msg)
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return enqueuedAt.Add(ttl)
+}
@@ -0,0 +1,45 @@
+package proto
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTimeToLive(t *testing.T) {
+	legacy := &timestamppb.Timestamp{Seconds: 30, Nanos: 500}
+	for _, c := range []struct {
+		name  string
+		queue *KokaqQueueRequest
+		msg   *KokaqMessageRequest
+		want  time.Duration
+	}{
+		{"unset", nil, nil, 0},
+		{"legacy default_expiry", &KokaqQueueRequest{DefaultExpiry: legacy}, nil, 30*time.Second + 500},
+		{"default_time_to_live over default_expiry", &KokaqQueueRequest{DefaultExpiry: legacy, DefaultTimeToLive: durationpb.New(time.Minute)}, nil, time.Minute},
+		{"message over queue", &KokaqQueueRequest{DefaultExpiry: legacy, DefaultTimeToLive: durationpb.New(time.Minute)}, &KokaqMessageRequest{TimeToLive: durationpb.New(time.Second)}, time.Second},
+		{"message without queue defaults", nil, &KokaqMessageRequest{TimeToLive: durationpb.New(time.Hour)}, time.Hour},
+		// An explicit zero on the message disables the queue default.
+		{"message zero", &KokaqQueueRequest{DefaultTimeToLive: durationpb.New(time.Minute)}, &KokaqMessageRequest{TimeToLive: durationpb.New(0)}, 0},
+	} {
+		if got := TimeToLive(c.queue, c.msg); got != c.want {
+			t.Errorf("%s: TimeToLive = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestExpiresAt(t *testing.T) {
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	q := &KokaqQueueRequest{DefaultTimeToLive: durationpb.New(time.Minute)}
+	if got := ExpiresAt(q, nil, at); !got.Equal(at.Add(time.Minute)) {
+		t.Errorf("ExpiresAt = %v, want %v", got, at.Add(time.Minute))
+	}
+	if got := ExpiresAt(nil, nil, at); !got.IsZero() {
+		t.Errorf("ExpiresAt without a time to live = %v, want zero", got)
+	}
+	if got := ExpiresAt(nil, &KokaqMessageRequest{TimeToLive: durationpb.New(-time.Second)}, at); !got.IsZero() {
+		t.Errorf("ExpiresAt with a negative time to live = %v, want zero", got)
+	}
+}
@@ -0,0 +1,35 @@
+package proto
+
+// MaxForwardHops is the hop_count past which a message is no longer
+// auto-forwarded and is dead-lettered with FailureReason.FORWARDING_LOOP.
+const MaxForwardHops = 16
+
+// ForwardingCycle returns the queue names forming a forward_to cycle among
+// queues of one namespace, starting and ending with the same queue, or nil if
+// there is none. The control plane rejects configurations that have one.
+// Dead-letter forwarding is not considered: a message only reaches a dead
+// letter queue again by failing again, and hop_count bounds that.
+func ForwardingCycle(queues []*KokaqQueueRequest) []string {
+	next := make(map[string]string, len(queues))
+	for _, q := range queues {
+		if q.GetForwardTo() != "" {
+			next[q.GetQueue()] = q.GetForwardTo()
+		}
+	}
+	done := make(map[string]bool)
+	for _, q := range queues {
+		var path []string
+		onPath := make(map[string]int)
+		for name := q.GetQueue(); name != "" && !done[name]; name = next[name] {
+			if i, ok := onPath[name]; ok {
+				return append(path[i:], name)
+			}
+			onPath[name] = len(path)
+			path = append(path, name)
+		}
+		for _, name := range path {
+			done[name] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package proto
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestForwardingCycle(t *testing.T) {
+	queues := func(edges ...string) []*KokaqQueueRequest {
+		var out []*KokaqQueueRequest
+		for i := 0; i < len(edges); i += 2 {
+			out = append(out, &KokaqQueueRequest{Queue: edges[i], ForwardTo: edges[i+1]})
+		}
+		return out
+	}
+	for _, c := range []struct {
+		name   string
+		queues []*KokaqQueueRequest
+		want   []string
+	}{
+		{"none", nil, nil},
+		{"no forwarding", queues("a", "", "b", ""), nil},
+		{"chain", queues("a", "b", "b", "c", "c", ""), nil},
+		{"target not listed", queues("a", "missing"), nil},
+		{"shared tail", queues("a", "c", "b", "c", "c", "d", "d", ""), nil},
+		{"self", queues("a", "a"), []string{"a", "a"}},
+		{"pair", queues("a", "b", "b", "a"), []string{"a", "b", "a"}},
+		{"entered from a chain", queues("a", "b", "b", "c", "c", "d", "d", "b"), []string{"b", "c", "d", "b"}},
+		{"after an acyclic chain", queues("x", "y", "y", "", "a", "b", "b", "a"), []string{"a", "b", "a"}},
+	} {
+		if got := ForwardingCycle(c.queues); !slices.Equal(got, c.want) {
+			t.Errorf("%s: ForwardingCycle = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
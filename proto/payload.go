@@ -0,0 +1,31 @@
+package proto
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// MaxPayloadReferenceURI is the longest PayloadReference.uri in bytes, on
+// gRPC as on TCP, where the reference travels in a metadata field with a
+// 1-byte size.
+const MaxPayloadReferenceURI = 215
+
+// Check reports whether r can be carried over both transports. A nil
+// reference is valid.
+func (r *PayloadReference) Check() error {
+	if r == nil {
+		return nil
+	}
+	if r.GetUri() == "" {
+		return fmt.Errorf("proto: payload reference without uri")
+	}
+	if n := len(r.GetUri()); n > MaxPayloadReferenceURI {
+		return fmt.Errorf("proto: payload reference uri is %d bytes, limit %d", n, MaxPayloadReferenceURI)
+	}
+	if h := r.GetSha256(); h != "" {
+		if b, err := hex.DecodeString(h); err != nil || len(b) != 32 {
+			return fmt.Errorf("proto: payload reference sha256 %q is not a hex encoded SHA-256", h)
+		}
+	}
+	return nil
+}
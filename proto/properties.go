@@ -0,0 +1,50 @@
+package proto
+
+import "fmt"
+
+// MaxPropertySize is the most bytes a property key and value may take
+// together, on gRPC as on TCP, where a property field has a 1-byte size.
+const MaxPropertySize = 253
+
+// CheckProperties reports the first property of h over MaxPropertySize.
+func (h *KokaqMessageHeaders) CheckProperties() error {
+	for k, v := range h.GetProperties() {
+		if n := len(k) + len(v); n > MaxPropertySize {
+			return fmt.Errorf("proto: property %q takes %d bytes, limit %d", k, n, MaxPropertySize)
+		}
+	}
+	return nil
+}
+
+// Property returns the application property stored under key.
+func (h *KokaqMessageHeaders) Property(key string) (string, bool) {
+	v, ok := h.GetProperties()[key]
+	return v, ok
+}
+
+// SetProperty stores an application property, allocating the map on first use.
+func (h *KokaqMessageHeaders) SetProperty(key, value string) {
+	if h.Properties == nil {
+		h.Properties = make(map[string]string)
+	}
+	h.Properties[key] = value
+}
+
+// DeleteProperty removes an application property.
+func (h *KokaqMessageHeaders) DeleteProperty(key string) {
+	delete(h.Properties, key)
+}
+
+// SetProperty stores an application property on msg, allocating its headers
+// on first use.
+func (m *KokaqMessageRequest) SetProperty(key, value string) {
+	if m.Headers == nil {
+		m.Headers = &KokaqMessageHeaders{}
+	}
+	m.Headers.SetProperty(key, value)
+}
+
+// Property returns the application property stored under key on msg.
+func (m *KokaqMessageRequest) Property(key string) (string, bool) {
+	return m.GetHeaders().Property(key)
+}
@@ -0,0 +1,8 @@
+package proto
+
+import protobuf "google.golang.org/protobuf/proto"
+
+// MessageSize returns the size msg counts for against a Quota.
+func MessageSize(msg *KokaqMessageRequest) uint64 {
+	return uint64(protobuf.Size(msg))
+}
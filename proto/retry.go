@@ -0,0 +1,45 @@
+package proto
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryDelay returns how long a message that has failed retryCount times
+// stays invisible before redelivery under p. A nil policy redelivers at once.
+// Jitter never takes the delay past max_delay.
+func RetryDelay(p *RetryPolicy, retryCount uint32) time.Duration {
+	// Computed in float64 nanoseconds, so a huge backoff cannot overflow
+	// before it is clamped.
+	d := baseRetryDelay(p, retryCount)
+	if j := min(max(p.GetJitter(), 0), 1); j > 0 && d > 0 {
+		d += (rand.Float64()*2 - 1) * j * d
+	}
+	if p.GetMaxDelay() != nil {
+		d = min(d, float64(p.GetMaxDelay().AsDuration()))
+	}
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(max(d, 0))
+}
+
+// baseRetryDelay returns the delay before jitter, in nanoseconds.
+func baseRetryDelay(p *RetryPolicy, retryCount uint32) float64 {
+	if retryCount == 0 {
+		return 0
+	}
+	initial := float64(p.GetInitialDelay().AsDuration())
+	switch p.GetBackoff() {
+	case BackoffKind_BACKOFF_LINEAR:
+		return initial * float64(retryCount)
+	case BackoffKind_BACKOFF_EXPONENTIAL:
+		m := p.GetMultiplier()
+		if m <= 0 {
+			m = 2
+		}
+		return initial * math.Pow(m, float64(retryCount-1))
+	}
+	return 0
+}
@@ -0,0 +1,62 @@
+package proto
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestRetryDelay(t *testing.T) {
+	exp := &RetryPolicy{
+		Backoff:      BackoffKind_BACKOFF_EXPONENTIAL,
+		InitialDelay: durationpb.New(time.Second),
+		MaxDelay:     durationpb.New(10 * time.Second),
+	}
+	for _, c := range []struct {
+		name  string
+		p     *RetryPolicy
+		retry uint32
+		want  time.Duration
+	}{
+		{"nil policy", nil, 3, 0},
+		{"first attempt", exp, 0, 0},
+		{"exponential", exp, 3, 4 * time.Second},
+		{"capped", exp, 10, 10 * time.Second},
+		{"linear", &RetryPolicy{Backoff: BackoffKind_BACKOFF_LINEAR, InitialDelay: durationpb.New(time.Second)}, 3, 3 * time.Second},
+		{"multiplier", &RetryPolicy{Backoff: BackoffKind_BACKOFF_EXPONENTIAL, InitialDelay: durationpb.New(time.Second), Multiplier: 3}, 3, 9 * time.Second},
+	} {
+		if got := RetryDelay(c.p, c.retry); got != c.want {
+			t.Errorf("%s: RetryDelay(%d) = %v, want %v", c.name, c.retry, got, c.want)
+		}
+	}
+}
+
+func TestRetryDelayJitterRespectsMaxDelay(t *testing.T) {
+	p := &RetryPolicy{
+		Backoff:      BackoffKind_BACKOFF_EXPONENTIAL,
+		InitialDelay: durationpb.New(time.Second),
+		MaxDelay:     durationpb.New(10 * time.Second),
+		Jitter:       0.5,
+	}
+	for range 1000 {
+		d := RetryDelay(p, 10)
+		if d < 5*time.Second || d > 10*time.Second {
+			t.Fatalf("RetryDelay = %v, want within [5s, 10s]", d)
+		}
+	}
+}
+
+func TestRetryDelayJitterDoesNotOverflow(t *testing.T) {
+	p := &RetryPolicy{
+		Backoff:      BackoffKind_BACKOFF_EXPONENTIAL,
+		InitialDelay: durationpb.New(time.Second),
+		Jitter:       0.5,
+	}
+	for range 1000 {
+		if d := RetryDelay(p, 100); d < time.Duration(math.MaxInt64/2) {
+			t.Fatalf("RetryDelay(100) = %v, want close to the largest duration", d)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package proto
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// DefaultWatchStatsInterval applies when a WatchStatsRequest leaves
+	// interval unset.
+	DefaultWatchStatsInterval = 5 * time.Second
+	// MinWatchStatsInterval is the shortest interval servers honour.
+	MinWatchStatsInterval = time.Second
+)
+
+// WatchStatsInterval returns the interval between the snapshots streamed for
+// req.
+func WatchStatsInterval(req *WatchStatsRequest) time.Duration {
+	if req.GetInterval() == nil {
+		return DefaultWatchStatsInterval
+	}
+	return max(req.GetInterval().AsDuration(), MinWatchStatsInterval)
+}
+
+// Canonical keys of KokaqStatsResponse.stats. Most mirror the QueueStats or
+// NamespaceStats field of the same name, queue_count only for a namespace.
+// Three are derived instead:
+//
+//	enqueues_per_minute    enqueue_rate * 60, rounded: enqueues in the last minute
+//	dequeues_per_minute    dequeue_rate * 60, rounded: dequeues in the last minute
+//	oldest_message_age_ms  oldest_message_age in whole milliseconds, 0 when unset
+const (
+	StatQueueCount         = "queue_count"
+	StatActiveCount        = "active_count"
+	StatLockedCount        = "locked_count"
+	StatScheduledCount     = "scheduled_count"
+	StatDeadLetteredCount  = "dead_lettered_count"
+	StatTotalBytes         = "total_bytes"
+	StatEnqueuesPerMinute  = "enqueues_per_minute"
+	StatDequeuesPerMinute  = "dequeues_per_minute"
+	StatOldestMessageAgeMs = "oldest_message_age_ms"
+	StatLockExpirations    = "lock_expirations"
+)
+
+// StatsMap returns s under the canonical keys, for KokaqStatsResponse.stats.
+func (s *QueueStats) StatsMap() map[string]uint64 {
+	return map[string]uint64{
+		StatActiveCount:        s.GetActiveCount(),
+		StatLockedCount:        s.GetLockedCount(),
+		StatScheduledCount:     s.GetScheduledCount(),
+		StatDeadLetteredCount:  s.GetDeadLetteredCount(),
+		StatTotalBytes:         s.GetTotalBytes(),
+		StatEnqueuesPerMinute:  perMinute(s.GetEnqueueRate()),
+		StatDequeuesPerMinute:  perMinute(s.GetDequeueRate()),
+		StatOldestMessageAgeMs: uint64(s.GetOldestMessageAge().AsDuration().Milliseconds()),
+		StatLockExpirations:    s.GetLockExpirations(),
+	}
+}
+
+// StatsMap returns s under the canonical keys, for KokaqStatsResponse.stats.
+func (s *NamespaceStats) StatsMap() map[string]uint64 {
+	return map[string]uint64{
+		StatQueueCount:         s.GetQueueCount(),
+		StatActiveCount:        s.GetActiveCount(),
+		StatLockedCount:        s.GetLockedCount(),
+		StatScheduledCount:     s.GetScheduledCount(),
+		StatDeadLetteredCount:  s.GetDeadLetteredCount(),
+		StatTotalBytes:         s.GetTotalBytes(),
+		StatEnqueuesPerMinute:  perMinute(s.GetEnqueueRate()),
+		StatDequeuesPerMinute:  perMinute(s.GetDequeueRate()),
+		StatOldestMessageAgeMs: uint64(s.GetOldestMessageAge().AsDuration().Milliseconds()),
+		StatLockExpirations:    s.GetLockExpirations(),
+	}
+}
+
+func perMinute(rate float64) uint64 {
+	return uint64(math.Round(rate * 60))
+}
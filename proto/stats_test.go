@@ -0,0 +1,59 @@
+package proto
+
+import (
+	"maps"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestStatsMap(t *testing.T) {
+	qs := &QueueStats{
+		ActiveCount:       1,
+		LockedCount:       2,
+		ScheduledCount:    3,
+		DeadLetteredCount: 4,
+		TotalBytes:        5,
+		EnqueueRate:       0.5,
+		DequeueRate:       1.0 / 60,
+		OldestMessageAge:  durationpb.New(1500*time.Millisecond + 900*time.Microsecond),
+		LockExpirations:   6,
+	}
+	want := map[string]uint64{
+		StatActiveCount:        1,
+		StatLockedCount:        2,
+		StatScheduledCount:     3,
+		StatDeadLetteredCount:  4,
+		StatTotalBytes:         5,
+		StatEnqueuesPerMinute:  30,
+		StatDequeuesPerMinute:  1,
+		StatOldestMessageAgeMs: 1500,
+		StatLockExpirations:    6,
+	}
+	if got := qs.StatsMap(); !maps.Equal(got, want) {
+		t.Errorf("QueueStats.StatsMap() = %v, want %v", got, want)
+	}
+
+	ns := &NamespaceStats{QueueCount: 7, EnqueueRate: 0.5, DequeueRate: 1.0 / 60, ActiveCount: 1, LockedCount: 2, ScheduledCount: 3, DeadLetteredCount: 4, TotalBytes: 5, LockExpirations: 6}
+	want[StatQueueCount] = 7
+	want[StatOldestMessageAgeMs] = 0
+	if got := ns.StatsMap(); !maps.Equal(got, want) {
+		t.Errorf("NamespaceStats.StatsMap() = %v, want %v", got, want)
+	}
+}
+
+func TestWatchStatsInterval(t *testing.T) {
+	for _, c := range []struct {
+		interval *durationpb.Duration
+		want     time.Duration
+	}{
+		{nil, DefaultWatchStatsInterval},
+		{durationpb.New(10 * time.Millisecond), MinWatchStatsInterval},
+		{durationpb.New(time.Minute), time.Minute},
+	} {
+		if got := WatchStatsInterval(&WatchStatsRequest{Interval: c.interval}); got != c.want {
+			t.Errorf("WatchStatsInterval(%v) = %v, want %v", c.interval, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package proto
+
+// AllowsSend reports whether a queue in status s accepts new messages, from
+// Enqueue, topic fan-out or auto-forwarding.
+func (s QueueStatus) AllowsSend() bool {
+	return s == QueueStatus_QUEUE_STATUS_ACTIVE || s == QueueStatus_QUEUE_STATUS_RECEIVE_DISABLED
+}
+
+// AllowsReceive reports whether a queue in status s hands out messages
+// through Dequeue, PeekLock and AcceptSession.
+func (s QueueStatus) AllowsReceive() bool {
+	return s == QueueStatus_QUEUE_STATUS_ACTIVE || s == QueueStatus_QUEUE_STATUS_SEND_DISABLED
+}
+
+// Enabled reports whether a queue in status s serves the remaining data
+// plane operations: Peek, Ack, Nack, lock renewal, reading messages and
+// changing their priority. DeleteMessage is served in every status.
+func (s QueueStatus) Enabled() bool {
+	return s != QueueStatus_QUEUE_STATUS_DISABLED
+}
@@ -0,0 +1,94 @@
+package proto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	// ErrStaleETag means the queue changed since the etag was read. Servers
+	// report it as ERROR_CONFLICT.
+	ErrStaleETag = errors.New("proto: etag does not match the current queue")
+	// ErrImmutableField means an update touched namespace, queue, created_on
+	// or status. Servers report it as ERROR_INVALID_ARGUMENT.
+	ErrImmutableField = errors.New("proto: field is immutable")
+)
+
+var immutableQueueFields = map[protoreflect.Name]bool{
+	"namespace":  true,
+	"queue":      true,
+	"created_on": true,
+	"status":     true, // changed through SetQueueStatus
+}
+
+// QueueETag returns the etag of the queue settings q. It fails when q cannot
+// be marshaled, e.g. when a string field holds invalid UTF-8.
+func QueueETag(q *KokaqQueueRequest) (string, error) {
+	b, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(q)
+	if err != nil {
+		return "", fmt.Errorf("proto: etag of queue %s/%s: %w", q.GetNamespace(), q.GetQueue(), err)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:16]), nil
+}
+
+// ApplyQueueUpdate returns a copy of current with the fields named by the
+// update mask taken from req.queue, or with every mutable field taken from it
+// when the mask is empty. current is not modified.
+func ApplyQueueUpdate(current *KokaqQueueRequest, req *UpdateQueueRequest) (*KokaqQueueRequest, error) {
+	src := req.GetQueue()
+	if src.GetNamespace() != current.GetNamespace() || src.GetQueue() != current.GetQueue() {
+		return nil, fmt.Errorf("%w: namespace and queue must name the queue being updated", ErrImmutableField)
+	}
+	etag, err := QueueETag(current)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetEtag() != etag {
+		return nil, ErrStaleETag
+	}
+	out := protobuf.Clone(current).(*KokaqQueueRequest)
+	src = protobuf.Clone(src).(*KokaqQueueRequest)
+	mask := req.GetUpdateMask()
+	if len(mask.GetPaths()) == 0 {
+		fields := out.ProtoReflect().Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			if fd := fields.Get(i); !immutableQueueFields[fd.Name()] {
+				copyField(out.ProtoReflect(), src.ProtoReflect(), []string{string(fd.Name())})
+			}
+		}
+		return out, nil
+	}
+	if !mask.IsValid(out) {
+		return nil, fmt.Errorf("proto: invalid update mask %v", mask.GetPaths())
+	}
+	for _, path := range mask.GetPaths() {
+		segs := strings.Split(path, ".")
+		if immutableQueueFields[protoreflect.Name(segs[0])] {
+			return nil, fmt.Errorf("%w: %s", ErrImmutableField, segs[0])
+		}
+		copyField(out.ProtoReflect(), src.ProtoReflect(), segs)
+	}
+	return out, nil
+}
+
+// copyField copies the field at path from src to dst, clearing it in dst when
+// src leaves it unset.
+func copyField(dst, src protoreflect.Message, path []string) {
+	fd := dst.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if len(path) > 1 {
+		copyField(dst.Mutable(fd).Message(), src.Get(fd).Message(), path[1:])
+		return
+	}
+	if src.Has(fd) {
+		dst.Set(fd, src.Get(fd))
+	} else {
+		dst.Clear(fd)
+	}
+}
@@ -0,0 +1,133 @@
+package proto
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func etag(t *testing.T, q *KokaqQueueRequest) string {
+	t.Helper()
+	e, err := QueueETag(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func update(t *testing.T, current, src *KokaqQueueRequest, paths ...string) (*KokaqQueueRequest, error) {
+	t.Helper()
+	req := &UpdateQueueRequest{Queue: src, Etag: etag(t, current)}
+	if paths != nil {
+		req.UpdateMask = &fieldmaskpb.FieldMask{Paths: paths}
+	}
+	return ApplyQueueUpdate(current, req)
+}
+
+func currentQueue() *KokaqQueueRequest {
+	return &KokaqQueueRequest{
+		Namespace:       "ns",
+		Queue:           "q",
+		CreatedOn:       timestamppb.New(time.Unix(1, 0)),
+		MaxDequeueCount: 3,
+		Labels:          map[string]string{"team": "payments"},
+		RetryPolicy: &RetryPolicy{
+			Backoff:      BackoffKind_BACKOFF_LINEAR,
+			InitialDelay: durationpb.New(time.Second),
+			MaxDelay:     durationpb.New(time.Minute),
+		},
+		Status: QueueStatus_QUEUE_STATUS_SEND_DISABLED,
+	}
+}
+
+func TestApplyQueueUpdateEmptyMask(t *testing.T) {
+	current := currentQueue()
+	before := protobuf.Clone(current)
+	out, err := update(t, current, &KokaqQueueRequest{Namespace: "ns", Queue: "q", MaxPriority: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &KokaqQueueRequest{
+		Namespace:   "ns",
+		Queue:       "q",
+		CreatedOn:   current.GetCreatedOn(),
+		MaxPriority: 9,
+		Status:      QueueStatus_QUEUE_STATUS_SEND_DISABLED,
+	}
+	if !protobuf.Equal(out, want) {
+		t.Errorf("empty mask:\n got %v\nwant %v", out, want)
+	}
+	if !protobuf.Equal(current, before) {
+		t.Error("ApplyQueueUpdate modified current")
+	}
+}
+
+func TestApplyQueueUpdateNestedPath(t *testing.T) {
+	src := &KokaqQueueRequest{Namespace: "ns", Queue: "q", RetryPolicy: &RetryPolicy{MaxDelay: durationpb.New(time.Hour)}}
+	out, err := update(t, currentQueue(), src, "retry_policy.max_delay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := out.GetRetryPolicy()
+	if p.GetMaxDelay().AsDuration() != time.Hour || p.GetInitialDelay().AsDuration() != time.Second || p.GetBackoff() != BackoffKind_BACKOFF_LINEAR {
+		t.Errorf("retry_policy = %v, want only max_delay changed", p)
+	}
+	if out.GetMaxDequeueCount() != 3 || out.GetLabels()["team"] != "payments" {
+		t.Errorf("fields outside the mask changed: %v", out)
+	}
+
+	// A nested path whose source is unset clears the field.
+	out, err = update(t, currentQueue(), &KokaqQueueRequest{Namespace: "ns", Queue: "q"}, "retry_policy.initial_delay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.GetRetryPolicy().GetInitialDelay() != nil || out.GetRetryPolicy().GetMaxDelay() == nil {
+		t.Errorf("retry_policy = %v, want only initial_delay cleared", out.GetRetryPolicy())
+	}
+}
+
+func TestApplyQueueUpdateRejects(t *testing.T) {
+	current := currentQueue()
+	src := &KokaqQueueRequest{Namespace: "ns", Queue: "q", MaxDequeueCount: 5}
+	for _, c := range []struct {
+		name string
+		req  *UpdateQueueRequest
+		want error
+	}{
+		{"stale etag", &UpdateQueueRequest{Queue: src, Etag: "stale"}, ErrStaleETag},
+		{"missing etag", &UpdateQueueRequest{Queue: src}, ErrStaleETag},
+		{"other queue", &UpdateQueueRequest{Queue: &KokaqQueueRequest{Namespace: "ns", Queue: "r"}, Etag: etag(t, current)}, ErrImmutableField},
+		{"status", &UpdateQueueRequest{Queue: src, Etag: etag(t, current), UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"max_dequeue_count", "status"}}}, ErrImmutableField},
+		{"created_on", &UpdateQueueRequest{Queue: src, Etag: etag(t, current), UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"created_on"}}}, ErrImmutableField},
+	} {
+		if _, err := ApplyQueueUpdate(current, c.req); !errors.Is(err, c.want) {
+			t.Errorf("%s: err = %v, want %v", c.name, err, c.want)
+		}
+	}
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"no_such_field"}}
+	if _, err := ApplyQueueUpdate(current, &UpdateQueueRequest{Queue: src, Etag: etag(t, current), UpdateMask: mask}); err == nil {
+		t.Error("unknown mask path accepted")
+	}
+}
+
+func TestQueueETag(t *testing.T) {
+	a, b := currentQueue(), currentQueue()
+	if etag(t, a) != etag(t, b) {
+		t.Error("equal settings have different etags")
+	}
+	b.MaxDequeueCount++
+	if etag(t, a) == etag(t, b) {
+		t.Error("changed settings keep their etag")
+	}
+	if _, err := QueueETag(&KokaqQueueRequest{Queue: "\xff"}); err == nil {
+		t.Error("QueueETag of invalid UTF-8 succeeded")
+	}
+	if _, err := ApplyQueueUpdate(&KokaqQueueRequest{Queue: "\xff"}, &UpdateQueueRequest{Queue: &KokaqQueueRequest{Queue: "\xff"}}); err == nil {
+		t.Error("ApplyQueueUpdate on invalid UTF-8 succeeded")
+	}
+}
@@ -0,0 +1,35 @@
+package reference
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+// Error carries the ErrorCode a server reports for a failed operation.
+type Error struct {
+	Code proto.ErrorCode
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Msg)
+}
+
+func errorf(code proto.ErrorCode, format string, args ...any) *Error {
+	return &Error{Code: code, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Code returns the ErrorCode carried by err: ERROR_NONE for nil and
+// ERROR_INTERNAL for errors not raised by this package.
+func Code(err error) proto.ErrorCode {
+	if err == nil {
+		return proto.ErrorCode_ERROR_NONE
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return proto.ErrorCode_ERROR_INTERNAL
+}
@@ -0,0 +1,61 @@
+package reference
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestExpiredMessagesAreDeadLettered(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		c := NewFakeClock(epoch)
+		q := NewQueue(&proto.KokaqQueueRequest{
+			Namespace:         "ns",
+			Queue:             "q",
+			EnableDeadLetter:  enabled,
+			DefaultTimeToLive: durationpb.New(time.Minute),
+		}, WithClock(c))
+		mustEnqueue(t, q,
+			msg("default", 0),
+			&proto.KokaqMessageRequest{Namespace: "ns", Queue: "q", MessageId: "short", TimeToLive: durationpb.New(time.Second)},
+			&proto.KokaqMessageRequest{Namespace: "ns", Queue: "q", MessageId: "long", TimeToLive: durationpb.New(time.Hour)},
+		)
+
+		c.Advance(time.Second)
+		if n := q.Len(); n != 2 {
+			t.Errorf("dead_letter=%v: %d messages after 1s, want 2", enabled, n)
+		}
+		c.Advance(time.Minute)
+		if n := q.Len(); n != 1 {
+			t.Errorf("dead_letter=%v: %d messages after 61s, want long only", enabled, n)
+		}
+
+		dead := q.DeadLetters()
+		if !enabled {
+			if len(dead) != 0 {
+				t.Errorf("dead_letter=false: %d dead letters kept", len(dead))
+			}
+			continue
+		}
+		if len(dead) != 2 {
+			t.Fatalf("dead letters = %v, want short and default", dead)
+		}
+		for i, want := range []struct {
+			id string
+			at time.Time
+		}{
+			{"short", epoch.Add(time.Second)},
+			{"default", epoch.Add(time.Second + time.Minute)},
+		} {
+			d := dead[i]
+			if d.GetMessage().GetMessageId() != want.id ||
+				d.GetMessage().GetHeaders().GetFailureReason() != proto.FailureReason_EXPIRED ||
+				d.GetState() != proto.MessageState_MESSAGE_STATE_DEAD_LETTERED ||
+				!d.GetDeadLetteredAt().AsTime().Equal(want.at) {
+				t.Errorf("dead letter %d = %v, want %s expired at %v", i, d, want.id, want.at)
+			}
+		}
+	}
+}
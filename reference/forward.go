@@ -0,0 +1,115 @@
+package reference
+
+import (
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// route follows forward_to from queue k and returns the enqueue of msg into
+// the queue it finally lands in, with hop_count raised once per hop. Once
+// hop_count reaches proto.MaxForwardHops the message stops where it is and
+// the enqueue is marked to go to that queue's dead letters. Every queue on the
+// way must allow sends. Callers hold s.mu.
+func (s *Shard) route(k queueKey, msg *proto.KokaqMessageRequest) (txnOp, *Queue, error) {
+	q, err := s.queue(k)
+	if err != nil {
+		return txnOp{}, nil, err
+	}
+	for {
+		if err := q.checkStatus(proto.QueueStatus.AllowsSend, "enqueue"); err != nil {
+			return txnOp{}, nil, err
+		}
+		if q.config.GetForwardTo() == "" {
+			break
+		}
+		if msg.GetHeaders().GetHopCount() >= proto.MaxForwardHops {
+			return txnOp{key: k, enqueue: msg, loop: true}, q, nil
+		}
+		k = queueKey{k.namespace, q.config.GetForwardTo()}
+		if q, err = s.queue(k); err != nil {
+			return txnOp{}, nil, err
+		}
+		msg = forwarded(msg, k.queue)
+	}
+	return txnOp{key: k, enqueue: msg}, q, nil
+}
+
+// ForwardDeadLetters moves the dead letters of every queue that sets
+// forward_dead_letters_to into that queue, following its forward_to. They
+// arrive keeping their failure_reason but not their time_to_live. A dead
+// letter already at the hop limit stays where it is and is marked
+// FailureReason.FORWARDING_LOOP once, on the first pass that finds it; one
+// whose target queue is missing, refuses sends or has no room under its quota
+// stays untouched. Dequeue and PeekLock on the shard run this first; servers
+// also run it periodically.
+func (s *Shard) ForwardDeadLetters() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	for k, src := range s.queues {
+		to := src.config.GetForwardDeadLettersTo()
+		if to == "" {
+			continue
+		}
+		src.mu.Lock()
+		src.sweep(now)
+		dead := src.dead
+		src.dead = nil
+		src.mu.Unlock()
+
+		var kept []*entry
+		for _, e := range dead {
+			if e.msg.GetHeaders().GetHopCount() >= proto.MaxForwardHops {
+				if e.msg.GetHeaders().GetFailureReason() != proto.FailureReason_FORWARDING_LOOP {
+					e.markLoop(now)
+				}
+				kept = append(kept, e)
+				continue
+			}
+			msg := forwarded(e.msg, to)
+			msg.TimeToLive = nil
+			op, dst, err := s.route(queueKey{k.namespace, to}, msg)
+			if err == nil {
+				// A move within the namespace, so only the target queue quota applies.
+				dst.mu.Lock()
+				if err = dst.admit([]*proto.KokaqMessageRequest{op.enqueue}, now); err == nil {
+					dst.charge(1, now)
+					op.apply(dst, now)
+					dst.broadcast()
+				}
+				dst.mu.Unlock()
+			}
+			if err != nil {
+				kept = append(kept, e)
+			}
+		}
+		// Dead letters kept back are older than any buried meanwhile.
+		src.mu.Lock()
+		src.dead = append(kept, src.dead...)
+		src.mu.Unlock()
+	}
+}
+
+// markLoop relabels the dead letter e as stuck in a forwarding loop.
+func (e *entry) markLoop(now time.Time) {
+	msg := protobuf.Clone(e.msg).(*proto.KokaqMessageRequest)
+	if msg.Headers == nil {
+		msg.Headers = &proto.KokaqMessageHeaders{}
+	}
+	msg.Headers.FailureReason = proto.FailureReason_FORWARDING_LOOP
+	e.msg = msg
+	e.deadLettered = now
+}
+
+// forwarded returns a copy of msg addressed to queue with one more hop.
+func forwarded(msg *proto.KokaqMessageRequest, queue string) *proto.KokaqMessageRequest {
+	cp := protobuf.Clone(msg).(*proto.KokaqMessageRequest)
+	cp.Queue = queue
+	if cp.Headers == nil {
+		cp.Headers = &proto.KokaqMessageHeaders{}
+	}
+	cp.Headers.HopCount++
+	return cp
+}
@@ -0,0 +1,112 @@
+package reference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+func TestForwardDeadLettersMarksLoopOnce(t *testing.T) {
+	c := NewFakeClock(epoch)
+	s := NewShard(WithShardClock(c))
+	a := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "a", EnableDeadLetter: true, MaxDequeueCount: 1, ForwardDeadLettersTo: "b"})
+	b := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "b"})
+	for _, m := range []*proto.KokaqMessageRequest{
+		{Namespace: "ns", Queue: "a", MessageId: "looping", Headers: &proto.KokaqMessageHeaders{HopCount: proto.MaxForwardHops}},
+		{Namespace: "ns", Queue: "a", MessageId: "fresh"},
+	} {
+		if _, err := s.Enqueue(&proto.EnqueueRequest{Message: m}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, id := range []string{"looping", "fresh"} {
+		resp, err := a.PeekLock(context.Background(), &proto.PeekLockRequest{MessageId: id})
+		if err != nil || len(resp.GetLocked()) != 1 {
+			t.Fatalf("PeekLock(%s) = %v, %v", id, resp, err)
+		}
+		if _, err := a.Nack(&proto.NackRequest{MessageId: id, LockId: resp.GetLocked()[0].GetLockId()}); err != nil {
+			t.Fatalf("Nack(%s): %v", id, err)
+		}
+	}
+
+	c.Advance(time.Minute)
+	s.ForwardDeadLetters()
+	marked := epoch.Add(time.Minute)
+	for range 3 {
+		c.Advance(time.Minute)
+		s.ForwardDeadLetters()
+		dead := a.DeadLetters()
+		if len(dead) != 1 || dead[0].GetMessage().GetMessageId() != "looping" {
+			t.Fatalf("dead letters of a = %v, want only looping", dead)
+		}
+		if r := dead[0].GetMessage().GetHeaders().GetFailureReason(); r != proto.FailureReason_FORWARDING_LOOP {
+			t.Errorf("failure_reason = %v, want FORWARDING_LOOP", r)
+		}
+		if at := dead[0].GetDeadLetteredAt().AsTime(); !at.Equal(marked) {
+			t.Errorf("dead_lettered_at = %v, want %v", at, marked)
+		}
+	}
+	if n := b.Len(); n != 1 {
+		t.Errorf("b holds %d messages, want fresh only", n)
+	}
+}
+
+func TestForwardTo(t *testing.T) {
+	s := NewShard(WithShardClock(NewFakeClock(epoch)))
+	a := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "a", ForwardTo: "b"})
+	b := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "b", ForwardTo: "c"})
+	c := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "c"})
+	if err := enqueueTo(s, "a", "m"); err != nil {
+		t.Fatal(err)
+	}
+	if a.Len() != 0 || b.Len() != 0 || c.Len() != 1 {
+		t.Fatalf("a=%d b=%d c=%d, want the message in c only", a.Len(), b.Len(), c.Len())
+	}
+	m, err := c.GetMessage(&proto.GetMessageRequest{MessageId: "m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.GetMessage().GetQueue() != "c" || m.GetMessage().GetHeaders().GetHopCount() != 2 {
+		t.Errorf("forwarded message = %v, want queue c after 2 hops", m.GetMessage())
+	}
+}
+
+func TestForwardToRefused(t *testing.T) {
+	s := NewShard(WithShardClock(NewFakeClock(epoch)))
+	x := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "x", ForwardTo: "missing"})
+	a := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "a", ForwardTo: "b"})
+	b := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "b"})
+	b.SetStatus(proto.QueueStatus_QUEUE_STATUS_SEND_DISABLED)
+	if err := enqueueTo(s, "x", "m"); Code(err) != proto.ErrorCode_ERROR_NOT_FOUND {
+		t.Errorf("Enqueue forwarded to a missing queue = %v, want ERROR_NOT_FOUND", err)
+	}
+	if err := enqueueTo(s, "a", "m"); Code(err) != proto.ErrorCode_ERROR_QUEUE_DISABLED {
+		t.Errorf("Enqueue forwarded to a send-disabled queue = %v, want ERROR_QUEUE_DISABLED", err)
+	}
+	if x.Len() != 0 || a.Len() != 0 || b.Len() != 0 {
+		t.Errorf("refused forwards stored messages: x=%d a=%d b=%d", x.Len(), a.Len(), b.Len())
+	}
+}
+
+func TestForwardToLoop(t *testing.T) {
+	s := NewShard(WithShardClock(NewFakeClock(epoch)))
+	a := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "a", ForwardTo: "b", EnableDeadLetter: true})
+	b := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "b", ForwardTo: "a", EnableDeadLetter: true})
+	if err := enqueueTo(s, "a", "m"); err != nil {
+		t.Fatal(err)
+	}
+	// Sixteen hops from a lead back to a, where the message is buried.
+	if a.Len() != 0 || b.Len() != 0 || len(b.DeadLetters()) != 0 {
+		t.Fatalf("a=%d b=%d, b dead=%d, want only a dead letter in a", a.Len(), b.Len(), len(b.DeadLetters()))
+	}
+	dead := a.DeadLetters()
+	if len(dead) != 1 {
+		t.Fatalf("dead letters of a = %v, want m", dead)
+	}
+	h := dead[0].GetMessage().GetHeaders()
+	if h.GetFailureReason() != proto.FailureReason_FORWARDING_LOOP || h.GetHopCount() != proto.MaxForwardHops {
+		t.Errorf("dead letter headers = %v, want FORWARDING_LOOP after %d hops", h, proto.MaxForwardHops)
+	}
+}
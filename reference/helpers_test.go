@@ -0,0 +1,55 @@
+package reference
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+var epoch = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func msg(id string, priority uint64) *proto.KokaqMessageRequest {
+	return &proto.KokaqMessageRequest{Namespace: "ns", Queue: "q", MessageId: id, Priority: priority}
+}
+
+func mustEnqueue(t *testing.T, q *Queue, msgs ...*proto.KokaqMessageRequest) {
+	t.Helper()
+	for _, m := range msgs {
+		if _, err := q.Enqueue(&proto.EnqueueRequest{Message: m}); err != nil {
+			t.Fatalf("Enqueue(%s): %v", m.GetMessageId(), err)
+		}
+	}
+}
+
+// fill enqueues n messages at each priority, with ids "p<priority>-<i>".
+func fill(t *testing.T, q *Queue, n int, priorities ...uint64) {
+	t.Helper()
+	for i := range n {
+		for _, p := range priorities {
+			mustEnqueue(t, q, msg(fmt.Sprintf("p%d-%d", p, i), p))
+		}
+	}
+}
+
+// dequeueIDs dequeues one message at a time until req yields nothing or n
+// messages were taken, and returns their ids.
+func dequeueIDs(t *testing.T, q *Queue, req *proto.DequeueRequest, n int) []string {
+	t.Helper()
+	var ids []string
+	for len(ids) < n {
+		resp, err := q.Dequeue(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Dequeue: %v", err)
+		}
+		if len(resp.GetMessages()) == 0 {
+			break
+		}
+		for _, m := range resp.GetMessages() {
+			ids = append(ids, m.GetMessage().GetMessageId())
+		}
+	}
+	return ids
+}
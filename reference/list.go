@@ -0,0 +1,149 @@
+package reference
+
+import (
+	"cmp"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultPageSize applies when a list request leaves page_size unset.
+	DefaultPageSize = 100
+	// MaxPageSize caps page_size.
+	MaxPageSize = 1000
+)
+
+// ListMessages returns one page of the queue's messages, locked or not.
+// Page tokens hold the position of the last message returned, so messages
+// enqueued during a walk appear if they sort after that position and
+// messages removed during a walk are simply skipped.
+func (q *Queue) ListMessages(req *proto.ListMessagesRequest) (*proto.ListMessagesResponse, error) {
+	if err := q.checkStatus(proto.QueueStatus.Enabled, "list messages"); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := q.now()
+	q.sweep(now)
+	page, next, err := q.page(req, func(*entry) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListMessagesResponse{NextPageToken: next}
+	for _, e := range page {
+		resp.Messages = append(resp.Messages, listed(e, now, req.GetIncludePayload()))
+	}
+	return resp, nil
+}
+
+// ListLockedMessages returns one page of the messages currently locked.
+func (q *Queue) ListLockedMessages(req *proto.ListMessagesRequest) (*proto.ListLockedMessagesResponse, error) {
+	if err := q.checkStatus(proto.QueueStatus.Enabled, "list locked messages"); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := q.now()
+	q.sweep(now)
+	page, next, err := q.page(req, func(e *entry) bool { return e.locked(now) })
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListLockedMessagesResponse{NextPageToken: next}
+	for _, e := range page {
+		resp.Locked = append(resp.Locked, &proto.LockedMessage{
+			Message:       listed(e, now, req.GetIncludePayload()),
+			LockExpiresAt: timestamppb.New(e.lockExpires),
+		})
+	}
+	return resp, nil
+}
+
+// position orders entries for listing.
+type position struct {
+	priority uint64
+	seq      uint64
+}
+
+func (q *Queue) page(req *proto.ListMessagesRequest, keep func(*entry) bool) ([]*entry, string, error) {
+	order := req.GetOrder()
+	after, err := decodePageToken(order, req.GetPageToken())
+	if err != nil {
+		return nil, "", err
+	}
+	size := int(req.GetPageSize())
+	if size == 0 {
+		size = DefaultPageSize
+	}
+	size = min(size, MaxPageSize)
+
+	var matched []*entry
+	for _, e := range q.messages {
+		if keep(e) && (after == nil || comparePositions(order, after, e.position()) < 0) {
+			matched = append(matched, e)
+		}
+	}
+	slices.SortFunc(matched, func(a, b *entry) int {
+		return comparePositions(order, a.position(), b.position())
+	})
+	if len(matched) <= size {
+		return matched, "", nil
+	}
+	matched = matched[:size]
+	return matched, encodePageToken(order, matched[size-1].position()), nil
+}
+
+func (e *entry) position() *position {
+	return &position{priority: e.msg.GetPriority(), seq: e.seq}
+}
+
+func comparePositions(order proto.MessageOrder, a, b *position) int {
+	if order == proto.MessageOrder_MESSAGE_ORDER_PRIORITY {
+		if c := cmp.Compare(b.priority, a.priority); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(a.seq, b.seq)
+}
+
+func encodePageToken(order proto.MessageOrder, p *position) string {
+	return base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, "%d.%d.%d", order, p.priority, p.seq))
+}
+
+func decodePageToken(order proto.MessageOrder, token string) (*position, error) {
+	if token == "" {
+		return nil, nil
+	}
+	var (
+		p          position
+		tokenOrder int32
+	)
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		_, err = fmt.Sscanf(string(raw), "%d.%d.%d", &tokenOrder, &p.priority, &p.seq)
+	}
+	if err != nil {
+		return nil, errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "malformed page token")
+	}
+	if proto.MessageOrder(tokenOrder) != order {
+		return nil, errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "page token was issued for %s", proto.MessageOrder(tokenOrder))
+	}
+	return &p, nil
+}
+
+// listed returns the listing view of e, without its payload unless asked.
+func listed(e *entry, now time.Time, includePayload bool) *proto.KokaqMessageResponse {
+	resp := e.response(now)
+	if !includePayload && len(resp.Message.GetPayload()) > 0 {
+		msg := protobuf.Clone(resp.Message).(*proto.KokaqMessageRequest)
+		msg.Payload = nil
+		resp.Message = msg
+	}
+	return resp
+}
@@ -0,0 +1,165 @@
+package reference
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+// walk lists every page of q, calling between after each page, and returns
+// the ids in listing order.
+func walk(t *testing.T, q *Queue, req *proto.ListMessagesRequest, between func(page int)) []string {
+	t.Helper()
+	var ids []string
+	for page := 0; ; page++ {
+		resp, err := q.ListMessages(req)
+		if err != nil {
+			t.Fatalf("ListMessages page %d: %v", page, err)
+		}
+		for _, m := range resp.GetMessages() {
+			ids = append(ids, m.GetMessage().GetMessageId())
+		}
+		if resp.GetNextPageToken() == "" {
+			return ids
+		}
+		if len(resp.GetMessages()) != int(req.GetPageSize()) {
+			t.Fatalf("page %d holds %d messages but is not the last", page, len(resp.GetMessages()))
+		}
+		if between != nil {
+			between(page)
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
+
+func TestListMessagesFullWalk(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"}, WithClock(NewFakeClock(epoch)))
+	var byEnqueue, byPriority []string
+	for i := range 20 {
+		id := fmt.Sprintf("m%02d", i)
+		mustEnqueue(t, q, msg(id, uint64(i%3)))
+		byEnqueue = append(byEnqueue, id)
+	}
+	for _, p := range []int{2, 1, 0} {
+		for i := p; i < 20; i += 3 {
+			byPriority = append(byPriority, fmt.Sprintf("m%02d", i))
+		}
+	}
+	for _, c := range []struct {
+		order proto.MessageOrder
+		want  []string
+	}{
+		{proto.MessageOrder_MESSAGE_ORDER_ENQUEUE_TIME, byEnqueue},
+		{proto.MessageOrder_MESSAGE_ORDER_PRIORITY, byPriority},
+	} {
+		got := walk(t, q, &proto.ListMessagesRequest{PageSize: 6, Order: c.order}, nil)
+		if !slices.Equal(got, c.want) {
+			t.Errorf("%v walk = %v, want %v", c.order, got, c.want)
+		}
+	}
+}
+
+func TestListMessagesWalkUnderChanges(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"}, WithClock(NewFakeClock(epoch)))
+	fill(t, q, 5, 1, 2)
+	got := walk(t, q, &proto.ListMessagesRequest{PageSize: 3}, func(page int) {
+		if page != 0 {
+			return
+		}
+		// p2-0, p2-1 and p2-2 were listed. Removing one of them and one yet
+		// to come, and adding messages on either side of the position
+		// reached, must not repeat or skip anything else.
+		for _, id := range []string{"p2-1", "p1-4"} {
+			if _, err := q.DeleteMessage(&proto.DeleteMessageRequest{MessageId: id}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		mustEnqueue(t, q, msg("late-high", 5), msg("late-low", 0))
+	})
+	want := []string{"p2-0", "p2-1", "p2-2", "p2-3", "p2-4", "p1-0", "p1-1", "p1-2", "p1-3", "late-low"}
+	if !slices.Equal(got, want) {
+		t.Errorf("walk = %v, want %v", got, want)
+	}
+}
+
+func TestListMessagesPageTokens(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"}, WithClock(NewFakeClock(epoch)))
+	fill(t, q, 3, 0)
+	resp, err := q.ListMessages(&proto.ListMessagesRequest{PageSize: 1, Order: proto.MessageOrder_MESSAGE_ORDER_PRIORITY})
+	if err != nil || resp.GetNextPageToken() == "" {
+		t.Fatalf("ListMessages = %v, %v, want a next page", resp, err)
+	}
+	for _, c := range []struct {
+		name string
+		req  *proto.ListMessagesRequest
+	}{
+		{"order changed", &proto.ListMessagesRequest{PageToken: resp.GetNextPageToken(), Order: proto.MessageOrder_MESSAGE_ORDER_ENQUEUE_TIME}},
+		{"not base64", &proto.ListMessagesRequest{PageToken: "!!"}},
+		{"garbage", &proto.ListMessagesRequest{PageToken: "Z2FyYmFnZQ"}},
+	} {
+		if _, err := q.ListMessages(c.req); Code(err) != proto.ErrorCode_ERROR_INVALID_ARGUMENT {
+			t.Errorf("%s: ListMessages = %v, want ERROR_INVALID_ARGUMENT", c.name, err)
+		}
+		if _, err := q.ListLockedMessages(c.req); Code(err) != proto.ErrorCode_ERROR_INVALID_ARGUMENT {
+			t.Errorf("%s: ListLockedMessages = %v, want ERROR_INVALID_ARGUMENT", c.name, err)
+		}
+	}
+}
+
+func TestListMessagesPageSize(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"}, WithClock(NewFakeClock(epoch)))
+	fill(t, q, MaxPageSize+1, 0)
+	for _, c := range []struct {
+		size uint32
+		want int
+	}{
+		{0, DefaultPageSize},
+		{MaxPageSize + 1, MaxPageSize},
+	} {
+		resp, err := q.ListMessages(&proto.ListMessagesRequest{PageSize: c.size})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := len(resp.GetMessages()); n != c.want || resp.GetNextPageToken() == "" {
+			t.Errorf("page_size %d: %d messages, next page %q, want %d and more", c.size, n, resp.GetNextPageToken(), c.want)
+		}
+	}
+}
+
+func TestListMessagesPayload(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"}, WithClock(NewFakeClock(epoch)))
+	mustEnqueue(t, q, &proto.KokaqMessageRequest{Namespace: "ns", Queue: "q", MessageId: "m", Payload: []byte("body")})
+	lock, err := q.PeekLock(context.Background(), &proto.PeekLockRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, include := range []bool{false, true} {
+		req := &proto.ListMessagesRequest{IncludePayload: include}
+		all, err := q.ListMessages(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		locked, err := q.ListLockedMessages(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(all.GetMessages()) != 1 || len(locked.GetLocked()) != 1 {
+			t.Fatalf("include_payload=%v: listed %v and %v", include, all, locked)
+		}
+		for _, m := range []*proto.KokaqMessageResponse{all.GetMessages()[0], locked.GetLocked()[0].GetMessage()} {
+			if got := len(m.GetMessage().GetPayload()) > 0; got != include {
+				t.Errorf("include_payload=%v: payload %q listed", include, m.GetMessage().GetPayload())
+			}
+		}
+		if l := locked.GetLocked()[0]; l.GetLockId() != "" || !l.GetLockExpiresAt().AsTime().Equal(lock.GetLocked()[0].GetLockExpiresAt().AsTime()) {
+			t.Errorf("locked listing = %v, want the expiry without the lock id", l)
+		}
+	}
+	// Stripping the listing leaves the stored message alone.
+	if m, err := q.GetMessage(&proto.GetMessageRequest{MessageId: "m"}); err != nil || string(m.GetMessage().GetPayload()) != "body" {
+		t.Errorf("GetMessage after listing = %v, %v", m, err)
+	}
+}
@@ -0,0 +1,134 @@
+package reference
+
+import (
+	"context"
+	"crypto/rand"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultLockDuration applies when neither the request nor the queue sets a
+// lock duration.
+const DefaultLockDuration = 30 * time.Second
+
+// PeekLock locks the requested message, or the next max_count available
+// messages when no message_id is given, long polling up to wait_time for
+// them. Selection and locking happen under one critical section, so
+// concurrent callers never receive the same message.
+func (q *Queue) PeekLock(ctx context.Context, req *proto.PeekLockRequest) (*proto.PeekLockResponse, error) {
+	sel, err := q.newSelector(req.GetPriorityRange(), req.GetPrioritySelection(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	d := q.lockDuration(req)
+	resp := &proto.PeekLockResponse{}
+	if id := req.GetMessageId(); id != "" {
+		if err := q.checkStatus(proto.QueueStatus.AllowsReceive, "peek-lock"); err != nil {
+			return nil, err
+		}
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		q.sweep(sel.now)
+		e := q.find(id)
+		if e == nil {
+			return nil, errorf(proto.ErrorCode_ERROR_NOT_FOUND, "message %q not found", id)
+		}
+		if !e.locked(sel.now) && !sel.now.Before(e.visibleAt) {
+			resp.Locked = append(resp.Locked, e.lock(sel.now, d))
+			record(&q.dequeues, sel.now, 1)
+		}
+		return resp, nil
+	}
+	var refused error
+	err = q.poll(ctx, req.GetWaitTime().AsDuration(), func(now time.Time) bool {
+		if refused = q.checkStatus(proto.QueueStatus.AllowsReceive, "peek-lock"); refused != nil {
+			return true
+		}
+		sel.now = now
+		for n := max(req.GetMaxCount(), 1); n > 0; n-- {
+			i := sel.next(q.messages)
+			if i < 0 {
+				break
+			}
+			resp.Locked = append(resp.Locked, q.messages[i].lock(now, d))
+		}
+		record(&q.dequeues, now, len(resp.Locked))
+		return len(resp.Locked) > 0
+	})
+	if err == nil {
+		err = refused
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Ack deletes a message held under lock_id.
+func (q *Queue) Ack(req *proto.AckRequest) (*proto.AckResponse, error) {
+	if err := q.checkStatus(proto.QueueStatus.Enabled, "ack"); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	i, err := q.held(req.GetMessageId(), req.GetLockId())
+	if err != nil {
+		return nil, err
+	}
+	q.messages = append(q.messages[:i], q.messages[i+1:]...)
+	return &proto.AckResponse{Acknowledged: true}, nil
+}
+
+// held returns the index of the copy of message id locked under lockID.
+// Copies sharing a message_id are told apart by their lock.
+func (q *Queue) held(id, lockID string) (int, error) {
+	found := false
+	for i, e := range q.messages {
+		if e.msg.GetMessageId() != id {
+			continue
+		}
+		if lockID != "" && e.lockID == lockID && e.locked(q.now()) {
+			return i, nil
+		}
+		found = true
+	}
+	if found {
+		return -1, errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "message %q is not locked by %q", id, lockID)
+	}
+	return -1, errorf(proto.ErrorCode_ERROR_NOT_FOUND, "message %q not found", id)
+}
+
+func (q *Queue) find(id string) *entry {
+	for _, e := range q.messages {
+		if e.msg.GetMessageId() == id {
+			return e
+		}
+	}
+	return nil
+}
+
+func (q *Queue) lockDuration(req *proto.PeekLockRequest) time.Duration {
+	if d := proto.LockTimeout(req); d > 0 {
+		return d
+	}
+	if d := proto.DefaultLockTimeout(q.config); d > 0 {
+		return d
+	}
+	return DefaultLockDuration
+}
+
+func (e *entry) lock(now time.Time, d time.Duration) *proto.LockedMessage {
+	e.lockID = rand.Text()
+	e.lockExpires = now.Add(d)
+	e.lastDequeued = now
+	resp := e.response(now)
+	resp.LockTimeout = durationpb.New(d)
+	return &proto.LockedMessage{
+		Message:       resp,
+		LockId:        e.lockID,
+		LockExpiresAt: timestamppb.New(e.lockExpires),
+	}
+}
@@ -0,0 +1,96 @@
+package reference
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+func TestPeekLockCompetingConsumers(t *testing.T) {
+	const (
+		consumers = 8
+		messages  = 500
+		batch     = 7
+	)
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"})
+	for i := range messages {
+		mustEnqueue(t, q, msg(fmt.Sprintf("m%d", i), uint64(i%3)))
+	}
+
+	var (
+		mu     sync.Mutex
+		locked = make(map[string]int)
+		wg     sync.WaitGroup
+	)
+	for range consumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				resp, err := q.PeekLock(context.Background(), &proto.PeekLockRequest{MaxCount: batch})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if len(resp.GetLocked()) == 0 {
+					return
+				}
+				mu.Lock()
+				for _, l := range resp.GetLocked() {
+					locked[l.GetMessage().GetMessage().GetMessageId()]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(locked) != messages {
+		t.Errorf("%d distinct messages locked, want %d", len(locked), messages)
+	}
+	for id, n := range locked {
+		if n != 1 {
+			t.Errorf("message %s locked %d times", id, n)
+		}
+	}
+}
+
+func TestAckRequiresLock(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"})
+	mustEnqueue(t, q, msg("m", 0))
+	resp, err := q.PeekLock(context.Background(), &proto.PeekLockRequest{})
+	if err != nil || len(resp.GetLocked()) != 1 {
+		t.Fatalf("PeekLock = %v, %v", resp, err)
+	}
+	if _, err := q.Ack(&proto.AckRequest{MessageId: "m", LockId: "other"}); err == nil {
+		t.Error("Ack with a foreign lock id succeeded")
+	}
+	if _, err := q.Ack(&proto.AckRequest{MessageId: "m", LockId: resp.GetLocked()[0].GetLockId()}); err != nil {
+		t.Errorf("Ack: %v", err)
+	}
+	if n := q.Len(); n != 0 {
+		t.Errorf("Len after Ack = %d, want 0", n)
+	}
+}
+
+func TestSettleCopiesSharingAnID(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"})
+	mustEnqueue(t, q, msg("m", 0), msg("m", 0))
+	resp, err := q.PeekLock(context.Background(), &proto.PeekLockRequest{MaxCount: 2})
+	if err != nil || len(resp.GetLocked()) != 2 {
+		t.Fatalf("PeekLock = %v, %v", resp, err)
+	}
+	first, second := resp.GetLocked()[0].GetLockId(), resp.GetLocked()[1].GetLockId()
+	if _, err := q.Nack(&proto.NackRequest{MessageId: "m", LockId: second}); err != nil {
+		t.Errorf("Nack of the second copy: %v", err)
+	}
+	if _, err := q.Ack(&proto.AckRequest{MessageId: "m", LockId: first}); err != nil {
+		t.Errorf("Ack of the first copy: %v", err)
+	}
+	if n := q.Len(); n != 1 {
+		t.Errorf("Len = %d, want the nacked copy only", n)
+	}
+}
@@ -0,0 +1,44 @@
+package reference
+
+import (
+	"slices"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+// GetMessage returns a message by id from the queue or its dead-letter
+// queue, without locking it.
+func (q *Queue) GetMessage(req *proto.GetMessageRequest) (*proto.KokaqMessageResponse, error) {
+	if err := q.checkStatus(proto.QueueStatus.Enabled, "get message"); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := q.now()
+	q.sweep(now)
+	if e := q.find(req.GetMessageId()); e != nil {
+		return e.response(now), nil
+	}
+	for _, e := range q.dead {
+		if e.msg.GetMessageId() == req.GetMessageId() {
+			return e.response(now), nil
+		}
+	}
+	return nil, errorf(proto.ErrorCode_ERROR_NOT_FOUND, "message %q not found", req.GetMessageId())
+}
+
+// DeleteMessage removes every copy of a message from the queue and its
+// dead-letter queue, whatever its state and whatever the queue status. Locks
+// held on it become invalid.
+func (q *Queue) DeleteMessage(req *proto.DeleteMessageRequest) (*proto.StatusResponse, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	match := func(e *entry) bool { return e.msg.GetMessageId() == req.GetMessageId() }
+	n := len(q.messages) + len(q.dead)
+	q.messages = slices.DeleteFunc(q.messages, match)
+	q.dead = slices.DeleteFunc(q.dead, match)
+	if len(q.messages)+len(q.dead) == n {
+		return nil, errorf(proto.ErrorCode_ERROR_NOT_FOUND, "message %q not found", req.GetMessageId())
+	}
+	return &proto.StatusResponse{Success: true}, nil
+}
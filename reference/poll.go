@@ -0,0 +1,77 @@
+package reference
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+// MaxWaitTime caps the wait_time of a long poll.
+const MaxWaitTime = 20 * time.Second
+
+// poll calls try with the queue locked until it reports success, wait has
+// elapsed or ctx is done, and always at least once. Between attempts it
+// sleeps until the queue changes or a scheduled message or lapsed lock may
+// have made a message available. A ctx deadline, measured on the wall clock,
+// ends the wait quietly like an elapsed wait_time; cancellation is an error.
+func (q *Queue) poll(ctx context.Context, wait time.Duration, try func(now time.Time) bool) error {
+	wait = min(wait, MaxWaitTime)
+	if dl, ok := ctx.Deadline(); ok {
+		wait = min(wait, time.Until(dl))
+	}
+	deadline := q.now().Add(wait)
+	for {
+		q.mu.Lock()
+		now := q.now()
+		q.sweep(now)
+		if try(now) || !now.Before(deadline) {
+			q.mu.Unlock()
+			return nil
+		}
+		changed := q.changed
+		sleep := deadline.Sub(now)
+		if next := q.nextChange(now); !next.IsZero() {
+			sleep = min(sleep, next.Sub(now))
+		}
+		q.mu.Unlock()
+
+		timer, stop := q.clock.Timer(sleep)
+		select {
+		case <-ctx.Done():
+			stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil
+			}
+			return errorf(proto.ErrorCode_ERROR_TIMEOUT, "%v", ctx.Err())
+		case <-changed:
+			stop()
+		case <-timer:
+		}
+	}
+}
+
+// broadcast wakes every long poll. Callers hold q.mu.
+func (q *Queue) broadcast() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
+
+// nextChange returns the earliest instant after now at which a message may
+// become available without any call being made, or the zero time.
+func (q *Queue) nextChange(now time.Time) time.Time {
+	var next time.Time
+	consider := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	for _, e := range q.messages {
+		consider(e.visibleAt)
+		if e.lockID != "" {
+			consider(e.lockExpires)
+		}
+	}
+	return next
+}
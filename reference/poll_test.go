@@ -0,0 +1,155 @@
+package reference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type dequeueResult struct {
+	resp *proto.DequeueResponse
+	err  error
+}
+
+// startDequeue runs a long-polling Dequeue and waits until it is parked on
+// the clock.
+func startDequeue(t *testing.T, ctx context.Context, q *Queue, c *FakeClock, wait time.Duration) <-chan dequeueResult {
+	t.Helper()
+	done := make(chan dequeueResult, 1)
+	go func() {
+		resp, err := q.Dequeue(ctx, &proto.DequeueRequest{WaitTime: durationpb.New(wait)})
+		done <- dequeueResult{resp, err}
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for c.Waiters() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("Dequeue did not park")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return done
+}
+
+func result(t *testing.T, done <-chan dequeueResult) dequeueResult {
+	t.Helper()
+	select {
+	case r := <-done:
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatal("Dequeue did not return")
+		return dequeueResult{}
+	}
+}
+
+func assertParked(t *testing.T, done <-chan dequeueResult) {
+	t.Helper()
+	select {
+	case r := <-done:
+		t.Fatalf("Dequeue returned early: %v, %v", r.resp, r.err)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func newPollQueue() (*Queue, *FakeClock) {
+	c := NewFakeClock(epoch)
+	return NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"}, WithClock(c)), c
+}
+
+func TestLongPollWakesOnEnqueue(t *testing.T) {
+	q, c := newPollQueue()
+	done := startDequeue(t, context.Background(), q, c, 10*time.Second)
+	mustEnqueue(t, q, msg("m", 0))
+
+	r := result(t, done)
+	if r.err != nil || len(r.resp.GetMessages()) != 1 {
+		t.Fatalf("Dequeue = %v, %v, want the enqueued message", r.resp, r.err)
+	}
+	if n := c.Waiters(); n != 0 {
+		t.Errorf("%d timers left after the poll returned", n)
+	}
+}
+
+func TestLongPollWaitTimeElapses(t *testing.T) {
+	q, c := newPollQueue()
+	done := startDequeue(t, context.Background(), q, c, 10*time.Second)
+	c.Advance(9 * time.Second)
+	assertParked(t, done)
+	c.Advance(time.Second)
+
+	r := result(t, done)
+	if r.err != nil || len(r.resp.GetMessages()) != 0 {
+		t.Fatalf("Dequeue = %v, %v, want an empty response", r.resp, r.err)
+	}
+}
+
+func TestLongPollMaxWaitTime(t *testing.T) {
+	q, c := newPollQueue()
+	done := startDequeue(t, context.Background(), q, c, time.Hour)
+	c.Advance(MaxWaitTime - time.Second)
+	assertParked(t, done)
+	c.Advance(time.Second)
+
+	if r := result(t, done); r.err != nil || len(r.resp.GetMessages()) != 0 {
+		t.Fatalf("Dequeue = %v, %v, want an empty response after MaxWaitTime", r.resp, r.err)
+	}
+}
+
+func TestLongPollScheduledMessage(t *testing.T) {
+	q, c := newPollQueue()
+	mustEnqueue(t, q, msg("m", 0))
+	locked, err := q.PeekLock(context.Background(), &proto.PeekLockRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.Nack(&proto.NackRequest{MessageId: "m", LockId: locked.GetLocked()[0].GetLockId(), RedeliverAfter: durationpb.New(3 * time.Second)}); err != nil {
+		t.Fatal(err)
+	}
+	done := startDequeue(t, context.Background(), q, c, 10*time.Second)
+	c.Advance(3 * time.Second)
+
+	if r := result(t, done); r.err != nil || len(r.resp.GetMessages()) != 1 {
+		t.Fatalf("Dequeue = %v, %v, want the redelivered message", r.resp, r.err)
+	}
+}
+
+func TestLongPollContextDeadline(t *testing.T) {
+	q, c := newPollQueue()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	done := startDequeue(t, ctx, q, c, 10*time.Second)
+
+	r := result(t, done)
+	if r.err != nil || len(r.resp.GetMessages()) != 0 {
+		t.Fatalf("Dequeue = %v, %v, want a quiet empty response", r.resp, r.err)
+	}
+	if n := c.Waiters(); n != 0 {
+		t.Errorf("%d timers left after the poll returned", n)
+	}
+}
+
+func TestLongPollContextCancel(t *testing.T) {
+	q, c := newPollQueue()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := startDequeue(t, ctx, q, c, 10*time.Second)
+	cancel()
+
+	if r := result(t, done); Code(r.err) != proto.ErrorCode_ERROR_TIMEOUT {
+		t.Fatalf("Dequeue error = %v, want ERROR_TIMEOUT", r.err)
+	}
+}
+
+func TestLongPollStatusChange(t *testing.T) {
+	q, c := newPollQueue()
+	done := startDequeue(t, context.Background(), q, c, 10*time.Second)
+	q.SetStatus(proto.QueueStatus_QUEUE_STATUS_RECEIVE_DISABLED)
+
+	if r := result(t, done); Code(r.err) != proto.ErrorCode_ERROR_QUEUE_DISABLED {
+		t.Fatalf("Dequeue error = %v, want ERROR_QUEUE_DISABLED", r.err)
+	}
+	if n := c.Waiters(); n != 0 {
+		t.Errorf("%d timers left after the poll returned", n)
+	}
+}
@@ -0,0 +1,77 @@
+package reference
+
+import (
+	"time"
+
+	"github.com/kokaq/protocol/filter"
+	"github.com/kokaq/protocol/proto"
+)
+
+// maxWeight caps the weighted-fair weight of a level, keeping the round robin
+// sums well inside int64 whatever the priority values.
+const maxWeight = 1 << 32
+
+// selector picks messages for one Dequeue or PeekLock call.
+//
+// Larger priority values are served first. Within a priority level messages
+// are served in enqueue order. PRIORITY_SELECTION_STRICT always serves the
+// highest non-empty level in [lo, hi]. PRIORITY_SELECTION_WEIGHTED_FAIR runs a
+// smooth weighted round robin over the non-empty levels, weighting level p by
+// p-lo+1 capped at maxWeight, so every level in range keeps being served while
+// higher levels receive proportionally more deliveries. The round robin state
+// lives on the queue and carries over between calls.
+type selector struct {
+	q      *Queue
+	mode   proto.PrioritySelection
+	filter *filter.Filter
+	now    time.Time
+	lo, hi uint64
+}
+
+// next returns the index in entries of the message to serve, or -1.
+func (s *selector) next(entries []*entry) int {
+	heads := make(map[uint64]int) // level -> index of its oldest eligible message
+	for i, e := range entries {
+		if !s.eligible(e) {
+			continue
+		}
+		if _, ok := heads[e.msg.GetPriority()]; !ok {
+			heads[e.msg.GetPriority()] = i
+		}
+	}
+	if len(heads) == 0 {
+		return -1
+	}
+	var level uint64
+	if s.mode == proto.PrioritySelection_PRIORITY_SELECTION_WEIGHTED_FAIR {
+		level = s.weightedLevel(heads)
+	} else {
+		for p := range heads {
+			level = max(level, p)
+		}
+	}
+	return heads[level]
+}
+
+func (s *selector) eligible(e *entry) bool {
+	p := e.msg.GetPriority()
+	return p >= s.lo && p <= s.hi && !e.locked(s.now) && !s.now.Before(e.visibleAt) && s.filter.Match(e.msg)
+}
+
+func (s *selector) weightedLevel(heads map[uint64]int) uint64 {
+	var (
+		total int64
+		best  uint64
+		found bool
+	)
+	for p := range heads {
+		w := int64(min(p-s.lo, maxWeight-1) + 1)
+		total += w
+		s.q.fair[p] += w
+		if !found || s.q.fair[p] > s.q.fair[best] || (s.q.fair[p] == s.q.fair[best] && p > best) {
+			best, found = p, true
+		}
+	}
+	s.q.fair[best] -= total
+	return best
+}
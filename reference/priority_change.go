@@ -0,0 +1,74 @@
+package reference
+
+import (
+	"github.com/kokaq/protocol/filter"
+	"github.com/kokaq/protocol/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ChangePriority moves a message to another priority level. The message keeps
+// its enqueue position, so it is served ahead of messages enqueued after it at
+// the new level.
+func (q *Queue) ChangePriority(req *proto.ChangePriorityRequest) (*proto.ChangePriorityResponse, error) {
+	if err := q.checkStatus(proto.QueueStatus.Enabled, "change priority"); err != nil {
+		return nil, err
+	}
+	if err := q.checkPriority(req.GetNewPriority()); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, e := range q.messages {
+		if e.msg.GetMessageId() != req.GetMessageId() {
+			continue
+		}
+		prev := e.msg.GetPriority()
+		e.setPriority(req.GetNewPriority())
+		q.broadcast()
+		return &proto.ChangePriorityResponse{Changed: prev != req.GetNewPriority(), PreviousPriority: prev}, nil
+	}
+	return nil, errorf(proto.ErrorCode_ERROR_NOT_FOUND, "message %q not found", req.GetMessageId())
+}
+
+// BulkChangePriority moves every message matching the request filter, oldest
+// first, to another priority level.
+func (q *Queue) BulkChangePriority(req *proto.BulkChangePriorityRequest) (*proto.BulkChangePriorityResponse, error) {
+	if err := q.checkStatus(proto.QueueStatus.Enabled, "change priority"); err != nil {
+		return nil, err
+	}
+	if req.GetFilter() == "" {
+		return nil, errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "filter is required")
+	}
+	f, err := filter.Parse(req.GetFilter())
+	if err != nil {
+		return nil, errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "%v", err)
+	}
+	if err := q.checkPriority(req.GetNewPriority()); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	resp := &proto.BulkChangePriorityResponse{}
+	for _, e := range q.messages {
+		if req.GetMaxCount() > 0 && resp.ChangedCount >= uint64(req.GetMaxCount()) {
+			break
+		}
+		if !f.Match(e.msg) || e.msg.GetPriority() == req.GetNewPriority() {
+			continue
+		}
+		e.setPriority(req.GetNewPriority())
+		resp.ChangedCount++
+	}
+	if resp.ChangedCount > 0 {
+		q.broadcast()
+	}
+	return resp, nil
+}
+
+// setPriority updates a copy so messages already handed to callers are not
+// mutated.
+func (e *entry) setPriority(p uint64) {
+	msg := protobuf.Clone(e.msg).(*proto.KokaqMessageRequest)
+	msg.Priority = p
+	e.msg = msg
+}
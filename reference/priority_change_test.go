@@ -0,0 +1,98 @@
+package reference
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+func newPriorityQueue() *Queue {
+	return NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q", MinPriority: 1, MaxPriority: 5}, WithClock(NewFakeClock(epoch)))
+}
+
+func TestChangePriority(t *testing.T) {
+	q := newPriorityQueue()
+	mustEnqueue(t, q, msg("a", 1), msg("b", 3), msg("c", 1))
+	before, err := q.GetMessage(&proto.GetMessageRequest{MessageId: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := q.ChangePriority(&proto.ChangePriorityRequest{MessageId: "a", NewPriority: 3})
+	if err != nil || !resp.GetChanged() || resp.GetPreviousPriority() != 1 {
+		t.Fatalf("ChangePriority = %v, %v, want changed from 1", resp, err)
+	}
+	if p := before.GetMessage().GetPriority(); p != 1 {
+		t.Errorf("message handed out earlier now has priority %d", p)
+	}
+	resp, err = q.ChangePriority(&proto.ChangePriorityRequest{MessageId: "a", NewPriority: 3})
+	if err != nil || resp.GetChanged() || resp.GetPreviousPriority() != 3 {
+		t.Errorf("ChangePriority to the same level = %v, %v, want unchanged", resp, err)
+	}
+	for _, c := range []struct {
+		req  *proto.ChangePriorityRequest
+		want proto.ErrorCode
+	}{
+		{&proto.ChangePriorityRequest{MessageId: "a", NewPriority: 0}, proto.ErrorCode_ERROR_INVALID_ARGUMENT},
+		{&proto.ChangePriorityRequest{MessageId: "a", NewPriority: 6}, proto.ErrorCode_ERROR_INVALID_ARGUMENT},
+		{&proto.ChangePriorityRequest{MessageId: "missing", NewPriority: 2}, proto.ErrorCode_ERROR_NOT_FOUND},
+	} {
+		if _, err := q.ChangePriority(c.req); Code(err) != c.want {
+			t.Errorf("ChangePriority(%v) = %v, want %v", c.req, err, c.want)
+		}
+	}
+
+	// a keeps its enqueue position, ahead of b at the new level.
+	if got, want := dequeueIDs(t, q, &proto.DequeueRequest{}, 3), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("dequeue order = %v, want %v", got, want)
+	}
+}
+
+func TestBulkChangePriority(t *testing.T) {
+	q := newPriorityQueue()
+	mustEnqueue(t, q, msg("a1", 1), msg("b", 2), msg("a2", 1), msg("a3", 4), msg("a4", 1))
+
+	for _, c := range []struct {
+		name string
+		req  *proto.BulkChangePriorityRequest
+	}{
+		{"no filter", &proto.BulkChangePriorityRequest{NewPriority: 4}},
+		{"bad filter", &proto.BulkChangePriorityRequest{Filter: "priority ==", NewPriority: 4}},
+		{"below min", &proto.BulkChangePriorityRequest{Filter: "priority == 1", NewPriority: 0}},
+		{"above max", &proto.BulkChangePriorityRequest{Filter: "priority == 1", NewPriority: 6}},
+	} {
+		if _, err := q.BulkChangePriority(c.req); Code(err) != proto.ErrorCode_ERROR_INVALID_ARGUMENT {
+			t.Errorf("%s: BulkChangePriority = %v, want ERROR_INVALID_ARGUMENT", c.name, err)
+		}
+	}
+
+	// a3 already sits at 4 and does not count against max_count.
+	req := &proto.BulkChangePriorityRequest{Filter: "message_id >= 'a' AND message_id < 'b'", NewPriority: 4, MaxCount: 2}
+	resp, err := q.BulkChangePriority(req)
+	if err != nil || resp.GetChangedCount() != 2 {
+		t.Fatalf("BulkChangePriority = %v, %v, want 2 changed", resp, err)
+	}
+	priorities := func() map[string]uint64 {
+		out := make(map[string]uint64)
+		for _, id := range []string{"a1", "b", "a2", "a3", "a4"} {
+			m, err := q.GetMessage(&proto.GetMessageRequest{MessageId: id})
+			if err != nil {
+				t.Fatal(err)
+			}
+			out[id] = m.GetMessage().GetPriority()
+		}
+		return out
+	}
+	if got := priorities(); got["a1"] != 4 || got["a2"] != 4 || got["a4"] != 1 || got["b"] != 2 {
+		t.Errorf("priorities after max_count 2 = %v, want the two oldest a's moved", got)
+	}
+
+	req.MaxCount = 0
+	if resp, err := q.BulkChangePriority(req); err != nil || resp.GetChangedCount() != 1 {
+		t.Errorf("unlimited BulkChangePriority = %v, %v, want only a4 changed", resp, err)
+	}
+	if got := priorities(); got["a4"] != 4 || got["b"] != 2 {
+		t.Errorf("priorities after the unlimited change = %v", got)
+	}
+}
@@ -0,0 +1,101 @@
+package reference
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+func TestStrictPriorityOrder(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"})
+	mustEnqueue(t, q, msg("low-1", 1), msg("high-1", 9), msg("mid-1", 5), msg("high-2", 9), msg("low-2", 1))
+
+	got := dequeueIDs(t, q, &proto.DequeueRequest{}, 10)
+	want := []string{"high-1", "high-2", "mid-1", "low-1", "low-2"}
+	if !slices.Equal(got, want) {
+		t.Errorf("dequeue order = %v, want %v", got, want)
+	}
+}
+
+func TestPriorityRange(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"})
+	mustEnqueue(t, q, msg("p1", 1), msg("p3", 3), msg("p5", 5), msg("p7", 7))
+
+	lo, hi := uint64(3), uint64(5)
+	got := dequeueIDs(t, q, &proto.DequeueRequest{PriorityRange: &proto.PriorityRange{Min: &lo, Max: &hi}}, 10)
+	if want := []string{"p5", "p3"}; !slices.Equal(got, want) {
+		t.Errorf("range [3, 5] dequeued %v, want %v", got, want)
+	}
+	got = dequeueIDs(t, q, &proto.DequeueRequest{PriorityRange: &proto.PriorityRange{Max: &lo}}, 10)
+	if want := []string{"p1"}; !slices.Equal(got, want) {
+		t.Errorf("range [, 3] dequeued %v, want %v", got, want)
+	}
+	if n := q.Len(); n != 1 {
+		t.Errorf("Len = %d, want 1", n)
+	}
+}
+
+func TestPriorityRangeEmpty(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q", MinPriority: 2, MaxPriority: 8})
+	for _, r := range []*proto.PriorityRange{
+		{Min: ptr(uint64(6)), Max: ptr(uint64(4))},
+		{Min: ptr(uint64(9))},
+		{Max: ptr(uint64(1))},
+	} {
+		_, err := q.Dequeue(context.Background(), &proto.DequeueRequest{PriorityRange: r})
+		if Code(err) != proto.ErrorCode_ERROR_INVALID_ARGUMENT {
+			t.Errorf("Dequeue with range %v: %v, want ERROR_INVALID_ARGUMENT", r, err)
+		}
+	}
+}
+
+func TestPriorityOutsideQueueBounds(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q", MinPriority: 2, MaxPriority: 8})
+	for _, p := range []uint64{1, 9} {
+		_, err := q.Enqueue(&proto.EnqueueRequest{Message: msg("m", p)})
+		if Code(err) != proto.ErrorCode_ERROR_INVALID_ARGUMENT {
+			t.Errorf("Enqueue at priority %d: %v, want ERROR_INVALID_ARGUMENT", p, err)
+		}
+	}
+}
+
+func TestWeightedFairShare(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"})
+	fill(t, q, 50, 0, 2)
+
+	// Levels 0 and 2 weigh 1 and 3, so every 4 deliveries take 3 from level 2.
+	got := dequeueIDs(t, q, &proto.DequeueRequest{PrioritySelection: proto.PrioritySelection_PRIORITY_SELECTION_WEIGHTED_FAIR}, 40)
+	high := 0
+	for i, id := range got {
+		if strings.HasPrefix(id, "p2-") {
+			high++
+		}
+		if (i+1)%4 == 0 && high != (i+1)/4*3 {
+			t.Fatalf("after %d deliveries %d came from level 2, want %d: %v", i+1, high, (i+1)/4*3, got[:i+1])
+		}
+	}
+}
+
+func TestWeightedFairHugePriorities(t *testing.T) {
+	q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"})
+	fill(t, q, 10, 1<<63, 1<<63+5, ^uint64(0))
+
+	got := dequeueIDs(t, q, &proto.DequeueRequest{PrioritySelection: proto.PrioritySelection_PRIORITY_SELECTION_WEIGHTED_FAIR}, 15)
+	served := make(map[string]int)
+	for _, id := range got {
+		served[id[:strings.Index(id, "-")]]++
+	}
+	if len(served) != 3 {
+		t.Fatalf("levels served: %v, want all 3", served)
+	}
+	for level, n := range served {
+		if n != 5 {
+			t.Errorf("level %s served %d times, want 5 with capped equal weights", level, n)
+		}
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,248 @@
+// Package reference is an in-memory implementation of the kokaq data plane
+// semantics. It is the executable specification servers are checked against
+// and is small enough to embed in client tests; it is not durable.
+//
+// It specifies priorities, locking, retries and dead-lettering, message
+// listing and management, long polling, transactions, topics, forwarding,
+// queue status, quotas and stats. Sessions (session_id, session_lock_id and
+// the session RPCs), duplicate detection (duplicate_detection_window), Peek
+// and the lock renewal RPCs are out of scope: the package ignores those fields
+// and does not implement those calls, so servers cannot be checked against it
+// for them.
+package reference
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kokaq/protocol/filter"
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Queue holds the messages of a single kokaq queue.
+type Queue struct {
+	mu       sync.Mutex
+	config   *proto.KokaqQueueRequest
+	clock    Clock
+	status   atomic.Int32  // a proto.QueueStatus
+	changed  chan struct{} // closed and replaced whenever messages may have become available
+	seq      uint64
+	messages []*entry // in enqueue order
+	dead     []*entry // dead-lettered, in dead-letter order
+	fair     map[uint64]int64
+	limiter  limiter
+
+	enqueues, dequeues []time.Time // within the last rateWindow
+	lockExpirations    uint64
+}
+
+type entry struct {
+	msg          *proto.KokaqMessageRequest
+	seq          uint64
+	createdOn    time.Time
+	lastDequeued time.Time
+	lockID       string
+	lockExpires  time.Time
+	visibleAt    time.Time
+	expiresAt    time.Time
+	retryCount   uint32
+	deadLettered time.Time
+	size         uint64 // proto.MessageSize at enqueue
+}
+
+// state classifies e at now.
+func (e *entry) state(now time.Time) proto.MessageState {
+	switch {
+	case !e.deadLettered.IsZero():
+		return proto.MessageState_MESSAGE_STATE_DEAD_LETTERED
+	case e.locked(now):
+		return proto.MessageState_MESSAGE_STATE_LOCKED
+	case now.Before(e.visibleAt):
+		return proto.MessageState_MESSAGE_STATE_SCHEDULED
+	}
+	return proto.MessageState_MESSAGE_STATE_VISIBLE
+}
+
+// locked reports whether e is held by an unexpired lock at now.
+func (e *entry) locked(now time.Time) bool {
+	return e.lockID != "" && now.Before(e.lockExpires)
+}
+
+// Option configures a Queue.
+type Option func(*Queue)
+
+// WithClock replaces the wall clock as the source of time.
+func WithClock(c Clock) Option {
+	return func(q *Queue) { q.clock = c }
+}
+
+// NewQueue returns an empty queue configured by config.
+func NewQueue(config *proto.KokaqQueueRequest, opts ...Option) *Queue {
+	q := &Queue{
+		config:  config,
+		clock:   realClock{},
+		changed: make(chan struct{}),
+		fair:    make(map[uint64]int64),
+	}
+	q.status.Store(int32(config.GetStatus()))
+	for _, opt := range opts {
+		opt(q)
+	}
+	return q
+}
+
+// Enqueue stores the request message. Its priority must lie within the
+// queue's min_priority and max_priority when the queue declares them, and
+// the queue quota must have room for it.
+func (q *Queue) Enqueue(req *proto.EnqueueRequest) (*proto.EnqueueResponse, error) {
+	if err := q.checkStatus(proto.QueueStatus.AllowsSend, "enqueue"); err != nil {
+		return nil, err
+	}
+	if err := q.checkMessage(req.GetMessage()); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := q.now()
+	if err := q.admit([]*proto.KokaqMessageRequest{req.GetMessage()}, now); err != nil {
+		return nil, err
+	}
+	q.charge(1, now)
+	e := q.insert(req.GetMessage(), now)
+	q.broadcast()
+	return &proto.EnqueueResponse{
+		MessageId:  e.msg.GetMessageId(),
+		EnqueuedAt: timestamppb.New(e.createdOn),
+	}, nil
+}
+
+func (q *Queue) checkMessage(msg *proto.KokaqMessageRequest) error {
+	if msg.GetMessageId() == "" {
+		return errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "message_id is required")
+	}
+	if err := msg.GetHeaders().CheckProperties(); err != nil {
+		return errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "%v", err)
+	}
+	if err := msg.GetPayloadRef().Check(); err != nil {
+		return errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "%v", err)
+	}
+	return q.checkPriority(msg.GetPriority())
+}
+
+// insert appends msg to the queue. Callers hold q.mu.
+func (q *Queue) insert(msg *proto.KokaqMessageRequest, now time.Time) *entry {
+	e := q.newEntry(msg, now)
+	q.messages = append(q.messages, e)
+	return e
+}
+
+func (q *Queue) newEntry(msg *proto.KokaqMessageRequest, now time.Time) *entry {
+	q.seq++
+	record(&q.enqueues, now, 1)
+	return &entry{
+		msg:       msg,
+		seq:       q.seq,
+		createdOn: now,
+		expiresAt: proto.ExpiresAt(q.config, msg, now),
+		size:      proto.MessageSize(msg),
+	}
+}
+
+// Dequeue removes and returns up to max_count unlocked messages, chosen by the
+// request's priority range, priority selection and filter. With wait_time set
+// it long polls until at least one message is available.
+func (q *Queue) Dequeue(ctx context.Context, req *proto.DequeueRequest) (*proto.DequeueResponse, error) {
+	sel, err := q.newSelector(req.GetPriorityRange(), req.GetPrioritySelection(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.DequeueResponse{}
+	var refused error
+	err = q.poll(ctx, req.GetWaitTime().AsDuration(), func(now time.Time) bool {
+		if refused = q.checkStatus(proto.QueueStatus.AllowsReceive, "dequeue"); refused != nil {
+			return true
+		}
+		sel.now = now
+		for n := max(req.GetMaxCount(), 1); n > 0; n-- {
+			i := sel.next(q.messages)
+			if i < 0 {
+				break
+			}
+			e := q.messages[i]
+			q.messages = append(q.messages[:i], q.messages[i+1:]...)
+			resp.Messages = append(resp.Messages, e.response(now))
+		}
+		record(&q.dequeues, now, len(resp.Messages))
+		return len(resp.Messages) > 0
+	})
+	if err == nil {
+		err = refused
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Len returns the number of messages held by the queue.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.sweep(q.now())
+	return len(q.messages)
+}
+
+func (q *Queue) checkPriority(p uint64) error {
+	lo, hi := q.config.GetMinPriority(), q.config.GetMaxPriority()
+	if p < lo || (hi > 0 && p > hi) {
+		return errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "priority %d outside [%d, %d]", p, lo, hi)
+	}
+	return nil
+}
+
+func (q *Queue) newSelector(r *proto.PriorityRange, mode proto.PrioritySelection, expr string) (*selector, error) {
+	f, err := filter.Parse(expr)
+	if err != nil {
+		return nil, errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "%v", err)
+	}
+	s := &selector{q: q, mode: mode, filter: f, now: q.now(), lo: q.config.GetMinPriority(), hi: ^uint64(0)}
+	if q.config.GetMaxPriority() > 0 {
+		s.hi = q.config.GetMaxPriority()
+	}
+	if r != nil && r.Min != nil {
+		s.lo = max(s.lo, r.GetMin())
+	}
+	if r != nil && r.Max != nil {
+		s.hi = min(s.hi, r.GetMax())
+	}
+	if s.lo > s.hi {
+		return nil, errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "empty priority range [%d, %d]", s.lo, s.hi)
+	}
+	return s, nil
+}
+
+func (e *entry) response(now time.Time) *proto.KokaqMessageResponse {
+	resp := &proto.KokaqMessageResponse{
+		Message:    e.msg,
+		CreatedOn:  timestamppb.New(e.createdOn),
+		RetryCount: e.retryCount,
+		State:      e.state(now),
+	}
+	if !e.lastDequeued.IsZero() {
+		resp.LastDequeued = timestamppb.New(e.lastDequeued)
+	}
+	if !e.expiresAt.IsZero() {
+		resp.Expiry = timestamppb.New(e.expiresAt)
+	}
+	if !e.deadLettered.IsZero() {
+		resp.DeadLetteredAt = timestamppb.New(e.deadLettered)
+	}
+	return resp
+}
+
+func (q *Queue) now() time.Time {
+	return q.clock.Now()
+}
@@ -0,0 +1,187 @@
+package reference
+
+import (
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+// limiter is a token bucket refilled at a quota's max_enqueue_rate and
+// holding up to one second's worth of enqueues.
+type limiter struct {
+	tokens float64
+	last   time.Time
+}
+
+// available returns the tokens the bucket holds at now.
+func (l *limiter) available(rate uint32, now time.Time) float64 {
+	if l.last.IsZero() {
+		return float64(rate)
+	}
+	return min(float64(rate), l.tokens+now.Sub(l.last).Seconds()*float64(rate))
+}
+
+// allow reports whether the bucket holds n tokens, without taking them.
+func (l *limiter) allow(rate uint32, n int, now time.Time) bool {
+	return rate == 0 || l.available(rate, now) >= float64(n)
+}
+
+// take removes n tokens. Callers check allow first.
+func (l *limiter) take(rate uint32, n int, now time.Time) {
+	if rate == 0 || n == 0 {
+		return
+	}
+	l.tokens = l.available(rate, now) - float64(n)
+	l.last = now
+}
+
+// usage is what a queue or namespace holds against its quota.
+type usage struct {
+	messages, bytes uint64
+}
+
+// admit checks that adding msgs to u stays within quota and that lim holds
+// the rate tokens for them. It takes no tokens: a write spans several scopes,
+// so callers take them once every scope admitted it and nothing can fail
+// anymore.
+func admit(scope string, quota *proto.Quota, u usage, msgs []*proto.KokaqMessageRequest, lim *limiter, now time.Time) error {
+	if quota == nil || len(msgs) == 0 {
+		return nil
+	}
+	for _, msg := range msgs {
+		size := proto.MessageSize(msg)
+		if m := quota.GetMaxMessageSize(); m > 0 && size > m {
+			return errorf(proto.ErrorCode_ERROR_QUOTA_EXCEEDED, "%s: message %q is %d bytes, limit %d", scope, msg.GetMessageId(), size, m)
+		}
+		u.messages++
+		u.bytes += size
+	}
+	if m := quota.GetMaxMessages(); m > 0 && u.messages > m {
+		return errorf(proto.ErrorCode_ERROR_QUOTA_EXCEEDED, "%s: limit of %d messages", scope, m)
+	}
+	if m := quota.GetMaxTotalBytes(); m > 0 && u.bytes > m {
+		return errorf(proto.ErrorCode_ERROR_QUOTA_EXCEEDED, "%s: limit of %d bytes", scope, m)
+	}
+	if !lim.allow(quota.GetMaxEnqueueRate(), len(msgs), now) {
+		return errorf(proto.ErrorCode_ERROR_QUOTA_EXCEEDED, "%s: limit of %d enqueues per second", scope, quota.GetMaxEnqueueRate())
+	}
+	return nil
+}
+
+// usage returns what the queue holds. Callers hold q.mu.
+func (q *Queue) usage() usage {
+	var u usage
+	for _, e := range q.messages {
+		u.messages++
+		u.bytes += e.size
+	}
+	for _, e := range q.dead {
+		u.messages++
+		u.bytes += e.size
+	}
+	return u
+}
+
+// admit checks msgs against the queue quota. Callers hold q.mu.
+func (q *Queue) admit(msgs []*proto.KokaqMessageRequest, now time.Time) error {
+	scope := "queue " + q.config.GetNamespace() + "/" + q.config.GetQueue()
+	return admit(scope, q.config.GetQuota(), q.usage(), msgs, &q.limiter, now)
+}
+
+// charge takes the rate tokens for n admitted enqueues. Callers hold q.mu.
+func (q *Queue) charge(n int, now time.Time) {
+	q.limiter.take(q.config.GetQuota().GetMaxEnqueueRate(), n, now)
+}
+
+type namespaceQuota struct {
+	quota   *proto.Quota
+	limiter limiter
+}
+
+// SetNamespaceQuota limits what the queues of namespace hold on this shard.
+// max_queues is left to the control plane, which creates queues. A nil quota
+// removes the limits.
+func (s *Shard) SetNamespaceQuota(namespace string, quota *proto.Quota) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if quota == nil {
+		delete(s.namespaces, namespace)
+		return
+	}
+	s.namespaces[namespace] = &namespaceQuota{quota: quota}
+}
+
+// admitNamespace checks msgs against the quota of namespace. Callers hold s.mu
+// but no queue lock.
+func (s *Shard) admitNamespace(namespace string, msgs []*proto.KokaqMessageRequest, now time.Time) error {
+	nq, ok := s.namespaces[namespace]
+	if !ok {
+		return nil
+	}
+	var u usage
+	for k, q := range s.queues {
+		if k.namespace != namespace {
+			continue
+		}
+		q.mu.Lock()
+		qu := q.usage()
+		q.mu.Unlock()
+		u.messages += qu.messages
+		u.bytes += qu.bytes
+	}
+	return admit("namespace "+namespace, nq.quota, u, msgs, &nq.limiter, now)
+}
+
+// chargeNamespace takes the rate tokens of namespace for n admitted enqueues.
+// Callers hold s.mu.
+func (s *Shard) chargeNamespace(namespace string, n int, now time.Time) {
+	if nq, ok := s.namespaces[namespace]; ok {
+		nq.limiter.take(nq.quota.GetMaxEnqueueRate(), n, now)
+	}
+}
+
+// enqueues returns the messages ops enqueue grouped by namespace and by queue.
+func enqueues(ops []txnOp) (byNamespace map[string][]*proto.KokaqMessageRequest, byQueue map[queueKey][]*proto.KokaqMessageRequest) {
+	byNamespace = make(map[string][]*proto.KokaqMessageRequest)
+	byQueue = make(map[queueKey][]*proto.KokaqMessageRequest)
+	for _, op := range ops {
+		if op.enqueue != nil {
+			byNamespace[op.key.namespace] = append(byNamespace[op.key.namespace], op.enqueue)
+			byQueue[op.key] = append(byQueue[op.key], op.enqueue)
+		}
+	}
+	return byNamespace, byQueue
+}
+
+// admitNamespaces checks each namespace's messages against its quota.
+// Callers hold s.mu but no queue lock.
+func (s *Shard) admitNamespaces(msgs map[string][]*proto.KokaqMessageRequest, now time.Time) error {
+	for ns, m := range msgs {
+		if err := s.admitNamespace(ns, m, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// admitQueues checks each queue's messages against its quota. Callers hold
+// the locks of queues.
+func admitQueues(queues map[queueKey]*Queue, msgs map[queueKey][]*proto.KokaqMessageRequest, now time.Time) error {
+	for k, m := range msgs {
+		if err := queues[k].admit(m, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// charge takes the rate tokens of the admitted enqueues grouped by namespace
+// and by queue. Callers hold s.mu and the locks of queues.
+func (s *Shard) charge(queues map[queueKey]*Queue, byNamespace map[string][]*proto.KokaqMessageRequest, byQueue map[queueKey][]*proto.KokaqMessageRequest, now time.Time) {
+	for ns, m := range byNamespace {
+		s.chargeNamespace(ns, len(m), now)
+	}
+	for k, m := range byQueue {
+		queues[k].charge(len(m), now)
+	}
+}
@@ -0,0 +1,66 @@
+package reference
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func enqueueTo(s *Shard, queue, id string) error {
+	_, err := s.Enqueue(&proto.EnqueueRequest{Message: &proto.KokaqMessageRequest{Namespace: "ns", Queue: queue, MessageId: id}})
+	return err
+}
+
+func TestRejectedEnqueueTakesNoNamespaceTokens(t *testing.T) {
+	s := NewShard(WithShardClock(NewFakeClock(epoch)))
+	s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "a", Quota: &proto.Quota{MaxMessages: 1}})
+	s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "b"})
+	s.SetNamespaceQuota("ns", &proto.Quota{MaxEnqueueRate: 2})
+
+	if err := enqueueTo(s, "a", "first"); err != nil {
+		t.Fatal(err)
+	}
+	if err := enqueueTo(s, "a", "over"); Code(err) != proto.ErrorCode_ERROR_QUOTA_EXCEEDED {
+		t.Fatalf("Enqueue past the queue limit = %v, want ERROR_QUOTA_EXCEEDED", err)
+	}
+	// The refused enqueue left the namespace its second token.
+	if err := enqueueTo(s, "b", "second"); err != nil {
+		t.Errorf("Enqueue to b: %v", err)
+	}
+	if err := enqueueTo(s, "b", "third"); Code(err) != proto.ErrorCode_ERROR_QUOTA_EXCEEDED {
+		t.Errorf("Enqueue past the namespace rate = %v, want ERROR_QUOTA_EXCEEDED", err)
+	}
+}
+
+func TestFailedCommitTakesNoRateTokens(t *testing.T) {
+	for _, point := range []string{"commit.validate", "commit.apply"} {
+		t.Run(point, func(t *testing.T) {
+			s := NewShard(WithShardClock(NewFakeClock(epoch)), WithFailpoint(func(p string) error {
+				if p == point {
+					return errors.New("injected")
+				}
+				return nil
+			}))
+			s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "a", Quota: &proto.Quota{MaxEnqueueRate: 1}})
+			s.SetNamespaceQuota("ns", &proto.Quota{MaxEnqueueRate: 1})
+
+			begin, err := s.BeginTxn(&proto.BeginTxnRequest{Timeout: durationpb.New(time.Minute)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			m := &proto.KokaqMessageRequest{Namespace: "ns", Queue: "a", MessageId: "staged"}
+			if _, err := s.Enqueue(&proto.EnqueueRequest{Message: m, TxnId: begin.GetTxnId()}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := s.CommitTxn(&proto.TxnRequest{TxnId: begin.GetTxnId()}); Code(err) != proto.ErrorCode_ERROR_TXN_ABORTED {
+				t.Fatalf("CommitTxn = %v, want ERROR_TXN_ABORTED", err)
+			}
+			if err := enqueueTo(s, "a", "direct"); err != nil {
+				t.Errorf("Enqueue after failed commit: %v", err)
+			}
+		})
+	}
+}
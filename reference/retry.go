@@ -0,0 +1,114 @@
+package reference
+
+import (
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Nack releases a message held under lock_id as a failed delivery. The
+// message becomes visible again after redeliver_after, or at once with
+// requeue_immediately, or otherwise after the queue retry_policy delay. Once
+// its retry_count reaches the queue max_dequeue_count it is dead-lettered.
+func (q *Queue) Nack(req *proto.NackRequest) (*proto.NackResponse, error) {
+	if err := q.checkStatus(proto.QueueStatus.Enabled, "nack"); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	i, err := q.held(req.GetMessageId(), req.GetLockId())
+	if err != nil {
+		return nil, err
+	}
+	e, now := q.messages[i], q.now()
+	e.lockID = ""
+	e.retryCount++
+	if q.exhausted(e) {
+		q.deadLetter(i, proto.FailureReason_MAX_RETRY_EXCEEDED, now)
+		return &proto.NackResponse{DeadLettered: true, RetryCount: e.retryCount}, nil
+	}
+	switch {
+	case req.GetRequeueImmediately():
+		e.visibleAt = now
+	case req.GetRedeliverAfter() != nil:
+		e.visibleAt = now.Add(req.GetRedeliverAfter().AsDuration())
+	default:
+		e.visibleAt = now.Add(proto.RetryDelay(q.config.GetRetryPolicy(), e.retryCount))
+	}
+	q.broadcast()
+	return &proto.NackResponse{
+		Requeued:   true,
+		VisibleAt:  timestamppb.New(e.visibleAt),
+		RetryCount: e.retryCount,
+	}, nil
+}
+
+// DeadLetters returns the dead-lettered messages, oldest first.
+func (q *Queue) DeadLetters() []*proto.KokaqMessageResponse {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := q.now()
+	q.sweep(now)
+	out := make([]*proto.KokaqMessageResponse, len(q.dead))
+	for i, e := range q.dead {
+		out[i] = e.response(now)
+	}
+	return out
+}
+
+// sweep applies what the passage of time implies: expired messages leave the
+// queue with FailureReason.EXPIRED and lapsed locks count as failed
+// deliveries under the retry policy.
+func (q *Queue) sweep(now time.Time) {
+	for i := 0; i < len(q.messages); i++ {
+		e := q.messages[i]
+		if !e.expiresAt.IsZero() && !now.Before(e.expiresAt) {
+			q.deadLetter(i, proto.FailureReason_EXPIRED, now)
+			i--
+			continue
+		}
+		if e.lockID == "" || e.locked(now) {
+			continue
+		}
+		e.lockID = ""
+		e.retryCount++
+		q.lockExpirations++
+		if q.exhausted(e) {
+			q.deadLetter(i, proto.FailureReason_MAX_RETRY_EXCEEDED, now)
+			i--
+			continue
+		}
+		e.visibleAt = e.lockExpires.Add(proto.RetryDelay(q.config.GetRetryPolicy(), e.retryCount))
+	}
+}
+
+func (q *Queue) exhausted(e *entry) bool {
+	limit := q.config.GetMaxDequeueCount()
+	return limit > 0 && e.retryCount >= limit
+}
+
+// deadLetter removes the message at index i and buries it.
+func (q *Queue) deadLetter(i int, reason proto.FailureReason, now time.Time) {
+	e := q.messages[i]
+	q.messages = append(q.messages[:i], q.messages[i+1:]...)
+	q.bury(e, reason, now)
+}
+
+// bury keeps e in the dead-letter queue when the queue enables
+// dead-lettering and drops it otherwise.
+func (q *Queue) bury(e *entry, reason proto.FailureReason, now time.Time) {
+	if !q.config.GetEnableDeadLetter() {
+		return
+	}
+	msg := protobuf.Clone(e.msg).(*proto.KokaqMessageRequest)
+	if msg.Headers == nil {
+		msg.Headers = &proto.KokaqMessageHeaders{}
+	}
+	msg.Headers.FailureReason = reason
+	e.msg = msg
+	e.lockID = ""
+	e.deadLettered = now
+	q.dead = append(q.dead, e)
+}
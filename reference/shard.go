@@ -0,0 +1,210 @@
+package reference
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultTxnTimeout applies when BeginTxn leaves timeout unset.
+	DefaultTxnTimeout = 30 * time.Second
+	// MaxTxnTimeout caps the timeout of a transaction.
+	MaxTxnTimeout = 5 * time.Minute
+)
+
+// Shard holds the queues served by one data plane shard and runs the
+// transactions spanning them.
+type Shard struct {
+	mu         sync.Mutex
+	clock      Clock
+	failpoint  func(point string) error
+	queues     map[queueKey]*Queue
+	topics     map[queueKey]*topic
+	txns       map[string]*txn
+	namespaces map[string]*namespaceQuota
+}
+
+type queueKey struct {
+	namespace, queue string
+}
+
+func (k queueKey) compare(o queueKey) int {
+	if c := cmp.Compare(k.namespace, o.namespace); c != 0 {
+		return c
+	}
+	return cmp.Compare(k.queue, o.queue)
+}
+
+// ShardOption configures a Shard.
+type ShardOption func(*Shard)
+
+// WithShardClock replaces the wall clock for the shard and its queues.
+func WithShardClock(c Clock) ShardOption {
+	return func(s *Shard) { s.clock = c }
+}
+
+// WithFailpoint installs a hook called at named points of CommitTxn: once at
+// "commit.validate" and then at "commit.apply" before each staged operation
+// takes effect. An error returned by the hook fails the commit at that point,
+// which lets tests check that a failing commit leaves no trace.
+func WithFailpoint(hook func(point string) error) ShardOption {
+	return func(s *Shard) { s.failpoint = hook }
+}
+
+// NewShard returns a shard without queues.
+func NewShard(opts ...ShardOption) *Shard {
+	s := &Shard{
+		clock:      realClock{},
+		queues:     make(map[queueKey]*Queue),
+		topics:     make(map[queueKey]*topic),
+		txns:       make(map[string]*txn),
+		namespaces: make(map[string]*namespaceQuota),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// AddQueue creates the queue described by config on the shard.
+func (s *Shard) AddQueue(config *proto.KokaqQueueRequest, opts ...Option) *Queue {
+	q := NewQueue(config, append([]Option{WithClock(s.clock)}, opts...)...)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queues[queueKey{config.GetNamespace(), config.GetQueue()}] = q
+	return q
+}
+
+// Queue returns the named queue.
+func (s *Shard) Queue(namespace, queue string) (*Queue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queue(queueKey{namespace, queue})
+}
+
+func (s *Shard) queue(k queueKey) (*Queue, error) {
+	q, ok := s.queues[k]
+	if !ok {
+		return nil, errorf(proto.ErrorCode_ERROR_NOT_FOUND, "queue %s/%s not found", k.namespace, k.queue)
+	}
+	return q, nil
+}
+
+// Enqueue routes the request to the message's queue, following forward_to,
+// fans it out when it names a topic, and stages it when txn_id is set. Quotas
+// of the namespace and queue are checked when the message is stored.
+func (s *Shard) Enqueue(req *proto.EnqueueRequest) (*proto.EnqueueResponse, error) {
+	msg := req.GetMessage()
+	if msg.GetTopic() != "" {
+		return s.publish(req)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, q, err := s.route(queueKey{msg.GetNamespace(), msg.GetQueue()}, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.checkMessage(op.enqueue); err != nil {
+		return nil, err
+	}
+	resp := &proto.EnqueueResponse{MessageId: msg.GetMessageId()}
+	if req.GetTxnId() != "" {
+		t, err := s.openTxn(req.GetTxnId())
+		if err != nil {
+			return nil, err
+		}
+		t.ops = append(t.ops, op)
+		return resp, nil
+	}
+	now := s.clock.Now()
+	msgs := []*proto.KokaqMessageRequest{op.enqueue}
+	if err := s.admitNamespace(op.key.namespace, msgs, now); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.admit(msgs, now); err != nil {
+		return nil, err
+	}
+	s.chargeNamespace(op.key.namespace, 1, now)
+	q.charge(1, now)
+	e := op.apply(q, now)
+	q.broadcast()
+	resp.EnqueuedAt = timestamppb.New(e.createdOn)
+	return resp, nil
+}
+
+// Dequeue routes the request to its queue.
+func (s *Shard) Dequeue(ctx context.Context, req *proto.DequeueRequest) (*proto.DequeueResponse, error) {
+	s.ForwardDeadLetters()
+	q, err := s.Queue(req.GetNamespace(), req.GetQueue())
+	if err != nil {
+		return nil, err
+	}
+	return q.Dequeue(ctx, req)
+}
+
+// PeekLock routes the request to its queue.
+func (s *Shard) PeekLock(ctx context.Context, req *proto.PeekLockRequest) (*proto.PeekLockResponse, error) {
+	s.ForwardDeadLetters()
+	q, err := s.Queue(req.GetNamespace(), req.GetQueue())
+	if err != nil {
+		return nil, err
+	}
+	return q.PeekLock(ctx, req)
+}
+
+// Ack routes the request to its queue, or stages it when txn_id is set. A
+// staged ack reports acknowledged=false; the lock must still be held when
+// the transaction commits.
+func (s *Shard) Ack(req *proto.AckRequest) (*proto.AckResponse, error) {
+	k := queueKey{req.GetNamespace(), req.GetQueue()}
+	if req.GetTxnId() == "" {
+		q, err := s.Queue(k.namespace, k.queue)
+		if err != nil {
+			return nil, err
+		}
+		return q.Ack(req)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, q, err := s.stage(req.GetTxnId(), k)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.checkStatus(proto.QueueStatus.Enabled, "ack"); err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	_, err = q.held(req.GetMessageId(), req.GetLockId())
+	q.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	t.ops = append(t.ops, txnOp{key: k, ackID: req.GetMessageId(), lockID: req.GetLockId()})
+	return &proto.AckResponse{}, nil
+}
+
+// lockQueues locks the queues in keys in a fixed order, so concurrent
+// commits cannot deadlock, and returns the function unlocking them.
+func lockQueues(queues map[queueKey]*Queue) func() {
+	keys := make([]queueKey, 0, len(queues))
+	for k := range queues {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, queueKey.compare)
+	for _, k := range keys {
+		queues[k].mu.Lock()
+	}
+	return func() {
+		for _, k := range keys {
+			queues[k].mu.Unlock()
+		}
+	}
+}
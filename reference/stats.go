@@ -0,0 +1,149 @@
+package reference
+
+import (
+	"context"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// rateWindow is the period enqueue and dequeue rates are averaged over.
+const rateWindow = time.Minute
+
+// record adds n events at now to the event times in *times, dropping those
+// older than rateWindow. Callers hold the lock of the queue owning times.
+func record(times *[]time.Time, now time.Time, n int) {
+	prune(times, now)
+	for range n {
+		*times = append(*times, now)
+	}
+}
+
+func prune(times *[]time.Time, now time.Time) {
+	i := 0
+	for i < len(*times) && now.Sub((*times)[i]) >= rateWindow {
+		i++
+	}
+	*times = (*times)[i:]
+}
+
+func rate(times *[]time.Time, now time.Time) float64 {
+	prune(times, now)
+	return float64(len(*times)) / rateWindow.Seconds()
+}
+
+// Stats returns a snapshot of the queue statistics.
+func (q *Queue) Stats() *proto.QueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := q.now()
+	q.sweep(now)
+	s := &proto.QueueStats{
+		Namespace:         q.config.GetNamespace(),
+		Queue:             q.config.GetQueue(),
+		DeadLetteredCount: uint64(len(q.dead)),
+		TotalBytes:        q.usage().bytes,
+		EnqueueRate:       rate(&q.enqueues, now),
+		DequeueRate:       rate(&q.dequeues, now),
+		LockExpirations:   q.lockExpirations,
+	}
+	for _, e := range q.messages {
+		switch e.state(now) {
+		case proto.MessageState_MESSAGE_STATE_VISIBLE:
+			s.ActiveCount++
+		case proto.MessageState_MESSAGE_STATE_LOCKED:
+			s.LockedCount++
+		case proto.MessageState_MESSAGE_STATE_SCHEDULED:
+			s.ScheduledCount++
+		}
+	}
+	if len(q.messages) > 0 {
+		s.OldestMessageAge = durationpb.New(now.Sub(q.messages[0].createdOn))
+	}
+	return s
+}
+
+// Stats returns a snapshot of the statistics of the namespace's queues on
+// this shard.
+func (s *Shard) Stats(namespace string) *proto.NamespaceStats {
+	s.mu.Lock()
+	var queues []*Queue
+	for k, q := range s.queues {
+		if k.namespace == namespace {
+			queues = append(queues, q)
+		}
+	}
+	s.mu.Unlock()
+
+	ns := &proto.NamespaceStats{Namespace: namespace, QueueCount: uint64(len(queues))}
+	var oldest time.Duration
+	for _, q := range queues {
+		qs := q.Stats()
+		ns.ActiveCount += qs.GetActiveCount()
+		ns.LockedCount += qs.GetLockedCount()
+		ns.ScheduledCount += qs.GetScheduledCount()
+		ns.DeadLetteredCount += qs.GetDeadLetteredCount()
+		ns.TotalBytes += qs.GetTotalBytes()
+		ns.EnqueueRate += qs.GetEnqueueRate()
+		ns.DequeueRate += qs.GetDequeueRate()
+		ns.LockExpirations += qs.GetLockExpirations()
+		oldest = max(oldest, qs.GetOldestMessageAge().AsDuration())
+	}
+	if oldest > 0 {
+		ns.OldestMessageAge = durationpb.New(oldest)
+	}
+	return ns
+}
+
+// WatchStats calls send with a snapshot of the queue named by req, or of its
+// namespace on this shard when req.queue is empty, at once and then every
+// proto.WatchStatsInterval until ctx is done or send fails. It returns nil
+// when ctx ends the watch and the send error otherwise.
+func (s *Shard) WatchStats(ctx context.Context, req *proto.WatchStatsRequest, send func(*proto.KokaqStatsResponse) error) error {
+	snapshot := func() (*proto.KokaqStatsResponse, error) {
+		if req.GetQueue() == "" {
+			ns := s.Stats(req.GetNamespace())
+			resp := &proto.KokaqStatsResponse{
+				Stats:  ns.StatsMap(),
+				Status: &proto.StatusResponse{Success: true},
+				Typed:  &proto.KokaqStatsResponse_Namespace{Namespace: ns},
+			}
+			s.mu.Lock()
+			if nq, ok := s.namespaces[req.GetNamespace()]; ok {
+				resp.Quota = nq.quota
+			}
+			s.mu.Unlock()
+			return resp, nil
+		}
+		q, err := s.Queue(req.GetNamespace(), req.GetQueue())
+		if err != nil {
+			return nil, err
+		}
+		qs := q.Stats()
+		return &proto.KokaqStatsResponse{
+			Stats:  qs.StatsMap(),
+			Status: &proto.StatusResponse{Success: true},
+			Labels: q.config.GetLabels(),
+			Quota:  q.config.GetQuota(),
+			Typed:  &proto.KokaqStatsResponse_Queue{Queue: qs},
+		}, nil
+	}
+	interval := proto.WatchStatsInterval(req)
+	for {
+		resp, err := snapshot()
+		if err != nil {
+			return err
+		}
+		if err := send(resp); err != nil {
+			return err
+		}
+		timer, stop := s.clock.Timer(interval)
+		select {
+		case <-ctx.Done():
+			stop()
+			return nil
+		case <-timer:
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package reference
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// statsFixture returns a queue holding, two seconds after epoch, one locked,
+// one scheduled, two visible and one dead-lettered message, after one lock
+// expired. Three deliveries were made and five messages enqueued.
+func statsFixture(t *testing.T) (*Shard, *FakeClock, *Queue, uint64) {
+	t.Helper()
+	c := NewFakeClock(epoch)
+	s := NewShard(WithShardClock(c))
+	q := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q", EnableDeadLetter: true})
+	var bytes uint64
+	for _, m := range []*proto.KokaqMessageRequest{
+		{Namespace: "ns", Queue: "q", MessageId: "locked", Payload: []byte("1")},
+		{Namespace: "ns", Queue: "q", MessageId: "scheduled", Payload: []byte("22")},
+		{Namespace: "ns", Queue: "q", MessageId: "lapsed", Payload: []byte("333")},
+		{Namespace: "ns", Queue: "q", MessageId: "visible"},
+		{Namespace: "ns", Queue: "q", MessageId: "expired", TimeToLive: durationpb.New(time.Second)},
+	} {
+		mustEnqueue(t, q, m)
+		bytes += proto.MessageSize(m)
+	}
+	lock := func(id string, d time.Duration) string {
+		resp, err := q.PeekLock(context.Background(), &proto.PeekLockRequest{MessageId: id, LockTimeout: durationpb.New(d)})
+		if err != nil || len(resp.GetLocked()) != 1 {
+			t.Fatalf("PeekLock(%s) = %v, %v", id, resp, err)
+		}
+		return resp.GetLocked()[0].GetLockId()
+	}
+	lock("locked", time.Minute)
+	if _, err := q.Nack(&proto.NackRequest{MessageId: "scheduled", LockId: lock("scheduled", time.Minute), RedeliverAfter: durationpb.New(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	lock("lapsed", time.Second)
+	c.Advance(2 * time.Second)
+	return s, c, q, bytes
+}
+
+func TestQueueStats(t *testing.T) {
+	_, c, q, bytes := statsFixture(t)
+	want := &proto.QueueStats{
+		Namespace:         "ns",
+		Queue:             "q",
+		ActiveCount:       2,
+		LockedCount:       1,
+		ScheduledCount:    1,
+		DeadLetteredCount: 1,
+		TotalBytes:        bytes,
+		EnqueueRate:       5 / rateWindow.Seconds(),
+		DequeueRate:       3 / rateWindow.Seconds(),
+		OldestMessageAge:  durationpb.New(2 * time.Second),
+		LockExpirations:   1,
+	}
+	if got := q.Stats(); !protobuf.Equal(got, want) {
+		t.Errorf("Stats() =\n%v\nwant\n%v", got, want)
+	}
+
+	// Rates only cover the last minute.
+	c.Advance(rateWindow)
+	got := q.Stats()
+	if got.GetEnqueueRate() != 0 || got.GetDequeueRate() != 0 {
+		t.Errorf("rates a minute later = %v, %v, want 0", got.GetEnqueueRate(), got.GetDequeueRate())
+	}
+}
+
+func TestShardStats(t *testing.T) {
+	s, c, q, _ := statsFixture(t)
+	other := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "other"})
+	s.AddQueue(&proto.KokaqQueueRequest{Namespace: "elsewhere", Queue: "q"})
+	mustEnqueue(t, other, &proto.KokaqMessageRequest{Namespace: "ns", Queue: "other", MessageId: "m"})
+	c.Advance(time.Second)
+
+	qs, oqs := q.Stats(), other.Stats()
+	ns := s.Stats("ns")
+	if ns.GetQueueCount() != 2 {
+		t.Errorf("queue_count = %d, want 2", ns.GetQueueCount())
+	}
+	if ns.GetActiveCount() != qs.GetActiveCount()+oqs.GetActiveCount() ||
+		ns.GetLockedCount() != qs.GetLockedCount() ||
+		ns.GetTotalBytes() != qs.GetTotalBytes()+oqs.GetTotalBytes() ||
+		ns.GetEnqueueRate() != qs.GetEnqueueRate()+oqs.GetEnqueueRate() ||
+		ns.GetLockExpirations() != 1 {
+		t.Errorf("namespace stats %v are not the sums of %v and %v", ns, qs, oqs)
+	}
+	if age := ns.GetOldestMessageAge().AsDuration(); age != 3*time.Second {
+		t.Errorf("oldest_message_age = %v, want the oldest queue's 3s", age)
+	}
+	if empty := s.Stats("none"); empty.GetQueueCount() != 0 || empty.GetOldestMessageAge() != nil {
+		t.Errorf("Stats of an unknown namespace = %v", empty)
+	}
+}
+
+// watch runs WatchStats for req until ctx is done, delivering snapshots on
+// the returned channel and the result of WatchStats on the other.
+func watch(ctx context.Context, s *Shard, req *proto.WatchStatsRequest, sendErr error) (<-chan *proto.KokaqStatsResponse, <-chan error) {
+	snaps := make(chan *proto.KokaqStatsResponse, 16)
+	done := make(chan error, 1)
+	go func() {
+		done <- s.WatchStats(ctx, req, func(resp *proto.KokaqStatsResponse) error {
+			snaps <- resp
+			return sendErr
+		})
+	}()
+	return snaps, done
+}
+
+func recv[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out")
+		panic("unreachable")
+	}
+}
+
+// parked waits until WatchStats sleeps on the clock.
+func parked(t *testing.T, c *FakeClock) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for c.Waiters() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("WatchStats did not park")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestWatchStats(t *testing.T) {
+	c := NewFakeClock(epoch)
+	s := NewShard(WithShardClock(c))
+	q := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q", Labels: map[string]string{"team": "payments"}})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Asking for 10ms gets proto.MinWatchStatsInterval.
+	snaps, done := watch(ctx, s, &proto.WatchStatsRequest{Namespace: "ns", Queue: "q", Interval: durationpb.New(10 * time.Millisecond)}, nil)
+
+	first := recv(t, snaps)
+	if first.GetQueue().GetActiveCount() != 0 || first.GetStats()[proto.StatActiveCount] != 0 || first.GetLabels()["team"] != "payments" {
+		t.Errorf("first snapshot = %v", first)
+	}
+	mustEnqueue(t, q, msg("m", 0))
+
+	parked(t, c)
+	c.Advance(proto.MinWatchStatsInterval - time.Millisecond)
+	select {
+	case resp := <-snaps:
+		t.Fatalf("snapshot before the interval elapsed: %v", resp)
+	default:
+	}
+	c.Advance(time.Millisecond)
+	if tick := recv(t, snaps); tick.GetQueue().GetActiveCount() != 1 || tick.GetStats()[proto.StatActiveCount] != 1 {
+		t.Errorf("snapshot after one interval = %v, want the enqueued message", tick)
+	}
+
+	parked(t, c)
+	cancel()
+	if err := recv(t, done); err != nil {
+		t.Errorf("WatchStats after cancel = %v, want nil", err)
+	}
+	if n := c.Waiters(); n != 0 {
+		t.Errorf("%d timers left after WatchStats returned", n)
+	}
+}
+
+func TestWatchStatsNamespace(t *testing.T) {
+	c := NewFakeClock(epoch)
+	s := NewShard(WithShardClock(c))
+	s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "a"})
+	s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "b"})
+	quota := &proto.Quota{MaxMessages: 10}
+	s.SetNamespaceQuota("ns", quota)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	snaps, done := watch(ctx, s, &proto.WatchStatsRequest{Namespace: "ns"}, nil)
+
+	resp := recv(t, snaps)
+	if resp.GetNamespace().GetQueueCount() != 2 || resp.GetStats()[proto.StatQueueCount] != 2 || !protobuf.Equal(resp.GetQuota(), quota) {
+		t.Errorf("namespace snapshot = %v", resp)
+	}
+	parked(t, c)
+	c.Advance(proto.DefaultWatchStatsInterval)
+	recv(t, snaps)
+	cancel()
+	if err := recv(t, done); err != nil {
+		t.Errorf("WatchStats after cancel = %v, want nil", err)
+	}
+}
+
+func TestWatchStatsErrors(t *testing.T) {
+	s := NewShard(WithShardClock(NewFakeClock(epoch)))
+	s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"})
+
+	_, done := watch(context.Background(), s, &proto.WatchStatsRequest{Namespace: "ns", Queue: "missing"}, nil)
+	if err := recv(t, done); Code(err) != proto.ErrorCode_ERROR_NOT_FOUND {
+		t.Errorf("WatchStats of a missing queue = %v, want ERROR_NOT_FOUND", err)
+	}
+
+	errSend := errors.New("stream closed")
+	_, done = watch(context.Background(), s, &proto.WatchStatsRequest{Namespace: "ns", Queue: "q"}, errSend)
+	if err := recv(t, done); !errors.Is(err, errSend) {
+		t.Errorf("WatchStats with a failing send = %v, want %v", err, errSend)
+	}
+}
@@ -0,0 +1,28 @@
+package reference
+
+import "github.com/kokaq/protocol/proto"
+
+// Status returns the queue status, initially the status of its config.
+func (q *Queue) Status() proto.QueueStatus {
+	return proto.QueueStatus(q.status.Load())
+}
+
+// SetStatus changes the queue status. Long polls in progress notice at once:
+// Dequeue and PeekLock calls waiting on a queue that stops allowing receives
+// fail with ERROR_QUEUE_DISABLED.
+func (q *Queue) SetStatus(s proto.QueueStatus) {
+	q.status.Store(int32(s))
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.broadcast()
+}
+
+// checkStatus fails with ERROR_QUEUE_DISABLED unless the queue status allows
+// op.
+func (q *Queue) checkStatus(allows func(proto.QueueStatus) bool, op string) error {
+	if s := q.Status(); !allows(s) {
+		return errorf(proto.ErrorCode_ERROR_QUEUE_DISABLED, "%s refused: queue %s/%s is %s",
+			op, q.config.GetNamespace(), q.config.GetQueue(), s)
+	}
+	return nil
+}
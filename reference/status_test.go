@@ -0,0 +1,26 @@
+package reference
+
+import (
+	"testing"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+func TestDeleteMessageInEveryStatus(t *testing.T) {
+	for _, s := range []proto.QueueStatus{
+		proto.QueueStatus_QUEUE_STATUS_ACTIVE,
+		proto.QueueStatus_QUEUE_STATUS_SEND_DISABLED,
+		proto.QueueStatus_QUEUE_STATUS_RECEIVE_DISABLED,
+		proto.QueueStatus_QUEUE_STATUS_DISABLED,
+	} {
+		q := NewQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"}, WithClock(NewFakeClock(epoch)))
+		mustEnqueue(t, q, msg("m", 0))
+		q.SetStatus(s)
+		if _, err := q.DeleteMessage(&proto.DeleteMessageRequest{Namespace: "ns", Queue: "q", MessageId: "m"}); err != nil {
+			t.Errorf("%v: DeleteMessage: %v", s, err)
+		}
+		if n := q.Len(); n != 0 {
+			t.Errorf("%v: %d messages left", s, n)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package reference
+
+import (
+	"slices"
+	"time"
+
+	"github.com/kokaq/protocol/filter"
+	"github.com/kokaq/protocol/proto"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type topic struct {
+	config    *proto.KokaqTopicRequest
+	createdOn time.Time
+	subs      []*subscription
+}
+
+func (t *topic) response() *proto.KokaqTopicResponse {
+	return &proto.KokaqTopicResponse{
+		Request:           t.config,
+		SubscriptionCount: uint64(len(t.subs)),
+		CreatedOn:         timestamppb.New(t.createdOn),
+	}
+}
+
+type subscription struct {
+	config *proto.KokaqSubscriptionRequest
+	filter *filter.Filter
+}
+
+// AddTopic creates the topic described by req on the shard. Adding a topic
+// that exists leaves it and its subscriptions as they are.
+func (s *Shard) AddTopic(req *proto.KokaqTopicRequest) *proto.KokaqTopicResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := queueKey{req.GetNamespace(), req.GetTopic()}
+	t, ok := s.topics[k]
+	if !ok {
+		t = &topic{config: req, createdOn: s.clock.Now()}
+		s.topics[k] = t
+	}
+	return t.response()
+}
+
+// GetTopic describes the topic named by req.
+func (s *Shard) GetTopic(req *proto.KokaqTopicRequest) (*proto.KokaqTopicResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.topic(queueKey{req.GetNamespace(), req.GetTopic()})
+	if err != nil {
+		return nil, err
+	}
+	return t.response(), nil
+}
+
+// DeleteTopic removes the topic named by req together with its
+// subscriptions. The subscription queues and their messages stay.
+func (s *Shard) DeleteTopic(req *proto.KokaqTopicRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := queueKey{req.GetNamespace(), req.GetTopic()}
+	if _, err := s.topic(k); err != nil {
+		return err
+	}
+	delete(s.topics, k)
+	return nil
+}
+
+func (s *Shard) topic(k queueKey) (*topic, error) {
+	t, ok := s.topics[k]
+	if !ok {
+		return nil, errorf(proto.ErrorCode_ERROR_NOT_FOUND, "topic %s/%s not found", k.namespace, k.queue)
+	}
+	return t, nil
+}
+
+// AddSubscription starts copying messages enqueued to a topic into the
+// subscription's queue. The topic and the queue must already exist on the
+// shard.
+func (s *Shard) AddSubscription(req *proto.KokaqSubscriptionRequest) error {
+	f, err := filter.Parse(req.GetFilter())
+	if err != nil {
+		return errorf(proto.ErrorCode_ERROR_INVALID_ARGUMENT, "%v", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.topic(queueKey{req.GetNamespace(), req.GetTopic()})
+	if err != nil {
+		return err
+	}
+	if _, err := s.queue(queueKey{req.GetNamespace(), req.GetQueue()}); err != nil {
+		return err
+	}
+	t.subs = slices.DeleteFunc(t.subs, func(sub *subscription) bool {
+		return sub.config.GetSubscription() == req.GetSubscription()
+	})
+	t.subs = append(t.subs, &subscription{config: req, filter: f})
+	return nil
+}
+
+// DeleteSubscription stops copying messages into the subscription's queue.
+// The topic stays, even without subscriptions.
+func (s *Shard) DeleteSubscription(req *proto.KokaqSubscriptionRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.topic(queueKey{req.GetNamespace(), req.GetTopic()})
+	if err != nil {
+		return err
+	}
+	n := len(t.subs)
+	t.subs = slices.DeleteFunc(t.subs, func(sub *subscription) bool {
+		return sub.config.GetSubscription() == req.GetSubscription()
+	})
+	if len(t.subs) == n {
+		return errorf(proto.ErrorCode_ERROR_NOT_FOUND, "subscription %q not found", req.GetSubscription())
+	}
+	return nil
+}
+
+// publish copies a topic message into every matching subscription queue, all
+// at once, or stages the copies when txn_id is set. A topic without matching
+// subscriptions accepts the message and stores no copy.
+func (s *Shard) publish(req *proto.EnqueueRequest) (*proto.EnqueueResponse, error) {
+	msg := req.GetMessage()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.topic(queueKey{msg.GetNamespace(), msg.GetTopic()})
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.EnqueueResponse{MessageId: msg.GetMessageId()}
+	var ops []txnOp
+	queues := make(map[queueKey]*Queue)
+	for _, sub := range t.subs {
+		if !sub.filter.Match(msg) {
+			continue
+		}
+		cp := protobuf.Clone(msg).(*proto.KokaqMessageRequest)
+		cp.Queue = sub.config.GetQueue()
+		op, q, err := s.route(queueKey{msg.GetNamespace(), cp.Queue}, cp)
+		if err != nil {
+			return nil, err
+		}
+		if err := q.checkMessage(op.enqueue); err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+		queues[op.key] = q
+		resp.Queues = append(resp.Queues, op.key.queue)
+	}
+	if req.GetTxnId() != "" {
+		t, err := s.openTxn(req.GetTxnId())
+		if err != nil {
+			return nil, err
+		}
+		t.ops = append(t.ops, ops...)
+		return resp, nil
+	}
+	now := s.clock.Now()
+	byNamespace, byQueue := enqueues(ops)
+	if err := s.admitNamespaces(byNamespace, now); err != nil {
+		return nil, err
+	}
+	unlock := lockQueues(queues)
+	defer unlock()
+	if err := admitQueues(queues, byQueue, now); err != nil {
+		return nil, err
+	}
+	s.charge(queues, byNamespace, byQueue, now)
+	for _, op := range ops {
+		op.apply(queues[op.key], now)
+	}
+	for _, q := range queues {
+		q.broadcast()
+	}
+	return resp, nil
+}
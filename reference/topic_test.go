@@ -0,0 +1,100 @@
+package reference
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kokaq/protocol/proto"
+)
+
+func publishTo(s *Shard, topic, id string, priority uint64) (*proto.EnqueueResponse, error) {
+	return s.Enqueue(&proto.EnqueueRequest{Message: &proto.KokaqMessageRequest{Namespace: "ns", Topic: topic, MessageId: id, Priority: priority}})
+}
+
+func TestTopicLifecycle(t *testing.T) {
+	s := NewShard(WithShardClock(NewFakeClock(epoch)))
+	s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"})
+	topic := &proto.KokaqTopicRequest{Namespace: "ns", Topic: "t"}
+	sub := &proto.KokaqSubscriptionRequest{Namespace: "ns", Topic: "t", Subscription: "s", Queue: "q"}
+
+	if _, err := publishTo(s, "t", "m", 0); Code(err) != proto.ErrorCode_ERROR_NOT_FOUND {
+		t.Errorf("publish before AddTopic = %v, want ERROR_NOT_FOUND", err)
+	}
+	if err := s.AddSubscription(sub); Code(err) != proto.ErrorCode_ERROR_NOT_FOUND {
+		t.Errorf("AddSubscription before AddTopic = %v, want ERROR_NOT_FOUND", err)
+	}
+
+	s.AddTopic(topic)
+	if resp, err := publishTo(s, "t", "m", 0); err != nil || len(resp.GetQueues()) != 0 {
+		t.Errorf("publish without subscriptions = %v, %v, want no queues", resp, err)
+	}
+	if err := s.AddSubscription(sub); err != nil {
+		t.Fatal(err)
+	}
+	if resp := s.AddTopic(topic); resp.GetSubscriptionCount() != 1 || !resp.GetCreatedOn().AsTime().Equal(epoch) {
+		t.Errorf("AddTopic of an existing topic = %v, want it unchanged", resp)
+	}
+	if err := s.DeleteSubscription(sub); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := publishTo(s, "t", "m", 0); err != nil || len(resp.GetQueues()) != 0 {
+		t.Errorf("publish after the last subscription went = %v, %v, want no queues", resp, err)
+	}
+
+	if err := s.DeleteTopic(topic); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetTopic(topic); Code(err) != proto.ErrorCode_ERROR_NOT_FOUND {
+		t.Errorf("GetTopic after DeleteTopic = %v, want ERROR_NOT_FOUND", err)
+	}
+	if _, err := publishTo(s, "t", "m", 0); Code(err) != proto.ErrorCode_ERROR_NOT_FOUND {
+		t.Errorf("publish after DeleteTopic = %v, want ERROR_NOT_FOUND", err)
+	}
+	if err := s.DeleteTopic(topic); Code(err) != proto.ErrorCode_ERROR_NOT_FOUND {
+		t.Errorf("second DeleteTopic = %v, want ERROR_NOT_FOUND", err)
+	}
+}
+
+func TestTopicFanOut(t *testing.T) {
+	s := NewShard(WithShardClock(NewFakeClock(epoch)))
+	all := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "all"})
+	urgent := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "urgent"})
+	s.AddTopic(&proto.KokaqTopicRequest{Namespace: "ns", Topic: "t"})
+	for _, sub := range []*proto.KokaqSubscriptionRequest{
+		{Namespace: "ns", Topic: "t", Subscription: "all", Queue: "all"},
+		{Namespace: "ns", Topic: "t", Subscription: "urgent", Queue: "urgent", Filter: "priority >= 5"},
+	} {
+		if err := s.AddSubscription(sub); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.AddSubscription(&proto.KokaqSubscriptionRequest{Namespace: "ns", Topic: "t", Subscription: "bad", Queue: "all", Filter: "priority >="}); Code(err) != proto.ErrorCode_ERROR_INVALID_ARGUMENT {
+		t.Errorf("AddSubscription with a bad filter = %v, want ERROR_INVALID_ARGUMENT", err)
+	}
+	if err := s.AddSubscription(&proto.KokaqSubscriptionRequest{Namespace: "ns", Topic: "t", Subscription: "lost", Queue: "missing"}); Code(err) != proto.ErrorCode_ERROR_NOT_FOUND {
+		t.Errorf("AddSubscription to a missing queue = %v, want ERROR_NOT_FOUND", err)
+	}
+
+	for _, c := range []struct {
+		id       string
+		priority uint64
+		want     []string
+	}{
+		{"low", 1, []string{"all"}},
+		{"high", 7, []string{"all", "urgent"}},
+	} {
+		resp, err := publishTo(s, "t", c.id, c.priority)
+		if err != nil {
+			t.Fatalf("publish %s: %v", c.id, err)
+		}
+		if got := slices.Sorted(slices.Values(resp.GetQueues())); !slices.Equal(got, c.want) {
+			t.Errorf("publish %s reached %v, want %v", c.id, got, c.want)
+		}
+	}
+	if all.Len() != 2 || urgent.Len() != 1 {
+		t.Errorf("all holds %d, urgent %d, want 2 and 1", all.Len(), urgent.Len())
+	}
+	if m, err := urgent.GetMessage(&proto.GetMessageRequest{MessageId: "high"}); err != nil || m.GetMessage().GetQueue() != "urgent" {
+		t.Errorf("copy in urgent = %v, %v", m, err)
+	}
+}
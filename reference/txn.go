@@ -0,0 +1,198 @@
+package reference
+
+import (
+	"crypto/rand"
+	"slices"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type txn struct {
+	expires time.Time
+	ops     []txnOp
+}
+
+// txnOp is one staged operation: an enqueue of msg, straight into the
+// dead-letter queue when loop is set, or an ack of the copy of ackID held
+// under lockID.
+type txnOp struct {
+	key     queueKey
+	enqueue *proto.KokaqMessageRequest
+	loop    bool
+	ackID   string
+	lockID  string
+}
+
+// apply performs op on q. Callers hold q.mu.
+func (op txnOp) apply(q *Queue, now time.Time) *entry {
+	switch {
+	case op.enqueue == nil:
+		i := slices.IndexFunc(q.messages, func(e *entry) bool {
+			return e.msg.GetMessageId() == op.ackID && e.lockID == op.lockID
+		})
+		q.messages = slices.Delete(q.messages, i, i+1)
+		return nil
+	case op.loop:
+		e := q.newEntry(op.enqueue, now)
+		q.bury(e, proto.FailureReason_FORWARDING_LOOP, now)
+		return e
+	}
+	return q.insert(op.enqueue, now)
+}
+
+// BeginTxn opens a transaction that aborts unless committed within timeout.
+func (s *Shard) BeginTxn(req *proto.BeginTxnRequest) (*proto.BeginTxnResponse, error) {
+	timeout := req.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = DefaultTxnTimeout
+	}
+	timeout = min(timeout, MaxTxnTimeout)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	for id, t := range s.txns {
+		if !now.Before(t.expires) {
+			delete(s.txns, id)
+		}
+	}
+	id := rand.Text()
+	t := &txn{expires: now.Add(timeout)}
+	s.txns[id] = t
+	return &proto.BeginTxnResponse{TxnId: id, ExpiresAt: timestamppb.New(t.expires)}, nil
+}
+
+// AbortTxn discards a transaction and everything staged in it.
+func (s *Shard) AbortTxn(req *proto.TxnRequest) (*proto.StatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.txns[req.GetTxnId()]; !ok {
+		return nil, errorf(proto.ErrorCode_ERROR_NOT_FOUND, "transaction %q not found", req.GetTxnId())
+	}
+	delete(s.txns, req.GetTxnId())
+	return &proto.StatusResponse{Success: true}, nil
+}
+
+// CommitTxn applies every staged operation or none of them. It fails with
+// ERROR_TXN_ABORTED when the transaction is not open, having expired, been
+// aborted or already committed, when an acked message is no longer held under
+// its lock, when a queue's status now refuses an operation, or when applying
+// an operation fails, and with ERROR_QUOTA_EXCEEDED when its enqueues would
+// break a quota; the transaction is gone afterwards either way.
+func (s *Shard) CommitTxn(req *proto.TxnRequest) (*proto.StatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.openTxn(req.GetTxnId())
+	if err != nil {
+		return nil, err
+	}
+	delete(s.txns, req.GetTxnId())
+	now := s.clock.Now()
+
+	queues := make(map[queueKey]*Queue)
+	for _, op := range t.ops {
+		q, err := s.queue(op.key)
+		if err != nil {
+			return nil, errorf(proto.ErrorCode_ERROR_TXN_ABORTED, "%v", err)
+		}
+		queues[op.key] = q
+	}
+	byNamespace, byQueue := enqueues(t.ops)
+	if err := s.admitNamespaces(byNamespace, now); err != nil {
+		return nil, err
+	}
+	unlock := lockQueues(queues)
+	defer unlock()
+
+	if err := s.fail("commit.validate"); err != nil {
+		return nil, err
+	}
+	for _, q := range queues {
+		q.sweep(now)
+	}
+	acked := make(map[txnOp]bool)
+	for _, op := range t.ops {
+		if op.enqueue != nil {
+			if err := queues[op.key].checkStatus(proto.QueueStatus.AllowsSend, "enqueue"); err != nil {
+				return nil, errorf(proto.ErrorCode_ERROR_TXN_ABORTED, "%v", err)
+			}
+			continue
+		}
+		if err := queues[op.key].checkStatus(proto.QueueStatus.Enabled, "ack"); err != nil {
+			return nil, errorf(proto.ErrorCode_ERROR_TXN_ABORTED, "%v", err)
+		}
+		if acked[op] {
+			return nil, errorf(proto.ErrorCode_ERROR_TXN_ABORTED, "message %q acked twice", op.ackID)
+		}
+		acked[op] = true
+		if _, err := queues[op.key].held(op.ackID, op.lockID); err != nil {
+			return nil, errorf(proto.ErrorCode_ERROR_TXN_ABORTED, "%v", err)
+		}
+	}
+	if err := admitQueues(queues, byQueue, now); err != nil {
+		return nil, err
+	}
+
+	// Nothing below mutates an existing entry, so restoring each queue's
+	// message slices, sequence and enqueue times undoes a partially applied
+	// commit.
+	type snapshot struct {
+		messages, dead []*entry
+		seq            uint64
+		enqueues       []time.Time
+	}
+	saved := make(map[*Queue]snapshot, len(queues))
+	for _, q := range queues {
+		saved[q] = snapshot{slices.Clone(q.messages), slices.Clone(q.dead), q.seq, slices.Clone(q.enqueues)}
+	}
+	for _, op := range t.ops {
+		if err := s.fail("commit.apply"); err != nil {
+			for q, snap := range saved {
+				q.messages, q.dead, q.seq, q.enqueues = snap.messages, snap.dead, snap.seq, snap.enqueues
+			}
+			return nil, err
+		}
+		op.apply(queues[op.key], now)
+	}
+	// Rate tokens are taken only now that the commit can no longer fail.
+	s.charge(queues, byNamespace, byQueue, now)
+	for _, q := range queues {
+		q.broadcast()
+	}
+	return &proto.StatusResponse{Success: true}, nil
+}
+
+// stage returns the open transaction id and the queue an operation staged in
+// it targets. Callers hold s.mu.
+func (s *Shard) stage(id string, k queueKey) (*txn, *Queue, error) {
+	t, err := s.openTxn(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	q, err := s.queue(k)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, q, nil
+}
+
+// openTxn returns transaction id if it is still open. Callers hold s.mu.
+func (s *Shard) openTxn(id string) (*txn, error) {
+	t, ok := s.txns[id]
+	if !ok || !s.clock.Now().Before(t.expires) {
+		delete(s.txns, id)
+		return nil, errorf(proto.ErrorCode_ERROR_TXN_ABORTED, "transaction %q is not open", id)
+	}
+	return t, nil
+}
+
+func (s *Shard) fail(point string) error {
+	if s.failpoint == nil {
+		return nil
+	}
+	if err := s.failpoint(point); err != nil {
+		return errorf(proto.ErrorCode_ERROR_TXN_ABORTED, "%s: %v", point, err)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kokaq/protocol/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// queueState is what a failed commit must leave untouched.
+type queueState struct {
+	messages, dead []string
+	seq            uint64
+}
+
+func stateOf(q *Queue) queueState {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var st queueState
+	for _, e := range q.messages {
+		st.messages = append(st.messages, fmt.Sprintf("%s@%d lock=%s", e.msg.GetMessageId(), e.seq, e.lockID))
+	}
+	for _, e := range q.dead {
+		st.dead = append(st.dead, fmt.Sprintf("%s@%d", e.msg.GetMessageId(), e.seq))
+	}
+	st.seq = q.seq
+	return st
+}
+
+func (st queueState) equal(o queueState) bool {
+	return slices.Equal(st.messages, o.messages) && slices.Equal(st.dead, o.dead) && st.seq == o.seq
+}
+
+type txnFixture struct {
+	shard  *Shard
+	clock  *FakeClock
+	a, b   *Queue
+	lockID string // lock on "held" in a
+}
+
+// newTxnFixture returns a shard whose queue a holds a locked message "held"
+// and a dead letter, and whose queue b holds one message.
+func newTxnFixture(t *testing.T, failpoint func(string) error) *txnFixture {
+	t.Helper()
+	c := NewFakeClock(epoch)
+	s := NewShard(WithShardClock(c), WithFailpoint(failpoint))
+	f := &txnFixture{
+		shard: s,
+		clock: c,
+		a:     s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "a", EnableDeadLetter: true, MaxDequeueCount: 1}),
+		b:     s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "b"}),
+	}
+	for _, m := range []*proto.KokaqMessageRequest{
+		{Namespace: "ns", Queue: "a", MessageId: "doomed"},
+		{Namespace: "ns", Queue: "a", MessageId: "held"},
+		{Namespace: "ns", Queue: "b", MessageId: "resident"},
+	} {
+		if _, err := s.Enqueue(&proto.EnqueueRequest{Message: m}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	doomed := f.lock(t, "doomed")
+	if resp, err := f.a.Nack(&proto.NackRequest{MessageId: "doomed", LockId: doomed}); err != nil || !resp.GetDeadLettered() {
+		t.Fatalf("Nack(doomed) = %v, %v, want it dead-lettered", resp, err)
+	}
+	f.lockID = f.lock(t, "held")
+	return f
+}
+
+func (f *txnFixture) lock(t *testing.T, id string) string {
+	t.Helper()
+	resp, err := f.a.PeekLock(context.Background(), &proto.PeekLockRequest{MessageId: id})
+	if err != nil || len(resp.GetLocked()) != 1 {
+		t.Fatalf("PeekLock(%s) = %v, %v", id, resp, err)
+	}
+	return resp.GetLocked()[0].GetLockId()
+}
+
+// stage opens a transaction enqueueing into a and b and acking "held".
+func (f *txnFixture) stage(t *testing.T, timeout time.Duration) string {
+	t.Helper()
+	begin, err := f.shard.BeginTxn(&proto.BeginTxnRequest{Timeout: durationpb.New(timeout)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := begin.GetTxnId()
+	for _, q := range []string{"a", "b"} {
+		m := &proto.KokaqMessageRequest{Namespace: "ns", Queue: q, MessageId: "txn-" + q}
+		if _, err := f.shard.Enqueue(&proto.EnqueueRequest{Message: m, TxnId: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := f.shard.Ack(&proto.AckRequest{Namespace: "ns", Queue: "a", MessageId: "held", LockId: f.lockID, TxnId: id}); err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func TestCommitTxnFailureLeavesNoTrace(t *testing.T) {
+	errInjected := errors.New("injected")
+	// Three staged operations, so "commit.apply" is reached three times.
+	for _, fail := range []struct {
+		point string
+		nth   int
+	}{
+		{"commit.validate", 1},
+		{"commit.apply", 1},
+		{"commit.apply", 2},
+		{"commit.apply", 3},
+	} {
+		t.Run(fmt.Sprintf("%s#%d", fail.point, fail.nth), func(t *testing.T) {
+			seen := 0
+			f := newTxnFixture(t, func(point string) error {
+				if point == fail.point {
+					if seen++; seen == fail.nth {
+						return errInjected
+					}
+				}
+				return nil
+			})
+			id := f.stage(t, time.Minute)
+			beforeA, beforeB := stateOf(f.a), stateOf(f.b)
+
+			_, err := f.shard.CommitTxn(&proto.TxnRequest{TxnId: id})
+			if Code(err) != proto.ErrorCode_ERROR_TXN_ABORTED {
+				t.Fatalf("CommitTxn = %v, want ERROR_TXN_ABORTED", err)
+			}
+			if after := stateOf(f.a); !after.equal(beforeA) {
+				t.Errorf("queue a changed:\n got %+v\nwant %+v", after, beforeA)
+			}
+			if after := stateOf(f.b); !after.equal(beforeB) {
+				t.Errorf("queue b changed:\n got %+v\nwant %+v", after, beforeB)
+			}
+			// The staged ack was not applied: the lock still settles the message.
+			if _, err := f.a.Ack(&proto.AckRequest{MessageId: "held", LockId: f.lockID}); err != nil {
+				t.Errorf("Ack after failed commit: %v", err)
+			}
+			if _, err := f.shard.CommitTxn(&proto.TxnRequest{TxnId: id}); Code(err) != proto.ErrorCode_ERROR_TXN_ABORTED {
+				t.Errorf("second CommitTxn = %v, want ERROR_TXN_ABORTED", err)
+			}
+		})
+	}
+}
+
+func TestCommitTxn(t *testing.T) {
+	f := newTxnFixture(t, nil)
+	id := f.stage(t, time.Minute)
+	if f.a.Len() != 1 || f.b.Len() != 1 {
+		t.Fatalf("staged operations visible before commit: a=%d b=%d", f.a.Len(), f.b.Len())
+	}
+	if _, err := f.shard.CommitTxn(&proto.TxnRequest{TxnId: id}); err != nil {
+		t.Fatalf("CommitTxn: %v", err)
+	}
+	// a: "held" acked, "txn-a" added; b: "txn-b" added.
+	if f.a.Len() != 1 || f.b.Len() != 2 {
+		t.Errorf("after commit a=%d b=%d, want 1 and 2", f.a.Len(), f.b.Len())
+	}
+	if _, err := f.a.GetMessage(&proto.GetMessageRequest{MessageId: "held"}); Code(err) != proto.ErrorCode_ERROR_NOT_FOUND {
+		t.Errorf("acked message still present: %v", err)
+	}
+}
+
+func TestCommitTxnExpired(t *testing.T) {
+	f := newTxnFixture(t, nil)
+	id := f.stage(t, time.Second)
+	beforeA, beforeB := stateOf(f.a), stateOf(f.b)
+	f.clock.Advance(2 * time.Second)
+
+	if _, err := f.shard.CommitTxn(&proto.TxnRequest{TxnId: id}); Code(err) != proto.ErrorCode_ERROR_TXN_ABORTED {
+		t.Fatalf("CommitTxn = %v, want ERROR_TXN_ABORTED", err)
+	}
+	if !stateOf(f.a).equal(beforeA) || !stateOf(f.b).equal(beforeB) {
+		t.Error("expired commit changed the queues")
+	}
+}
+
+func TestStageExpired(t *testing.T) {
+	f := newTxnFixture(t, nil)
+	begin, err := f.shard.BeginTxn(&proto.BeginTxnRequest{Timeout: durationpb.New(time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.clock.Advance(time.Second)
+	m := &proto.KokaqMessageRequest{Namespace: "ns", Queue: "a", MessageId: "late"}
+	if _, err := f.shard.Enqueue(&proto.EnqueueRequest{Message: m, TxnId: begin.GetTxnId()}); Code(err) != proto.ErrorCode_ERROR_TXN_ABORTED {
+		t.Errorf("Enqueue into expired transaction = %v, want ERROR_TXN_ABORTED", err)
+	}
+}
+
+func TestCommitTxnAborted(t *testing.T) {
+	f := newTxnFixture(t, nil)
+	id := f.stage(t, time.Minute)
+	beforeA, beforeB := stateOf(f.a), stateOf(f.b)
+	if _, err := f.shard.AbortTxn(&proto.TxnRequest{TxnId: id}); err != nil {
+		t.Fatalf("AbortTxn: %v", err)
+	}
+
+	if _, err := f.shard.CommitTxn(&proto.TxnRequest{TxnId: id}); Code(err) != proto.ErrorCode_ERROR_TXN_ABORTED {
+		t.Fatalf("CommitTxn = %v, want ERROR_TXN_ABORTED", err)
+	}
+	m := &proto.KokaqMessageRequest{Namespace: "ns", Queue: "a", MessageId: "late"}
+	if _, err := f.shard.Enqueue(&proto.EnqueueRequest{Message: m, TxnId: id}); Code(err) != proto.ErrorCode_ERROR_TXN_ABORTED {
+		t.Errorf("Enqueue into aborted transaction = %v, want ERROR_TXN_ABORTED", err)
+	}
+	if !stateOf(f.a).equal(beforeA) || !stateOf(f.b).equal(beforeB) {
+		t.Error("aborted transaction changed the queues")
+	}
+}
+
+func TestCommitTxnLockLost(t *testing.T) {
+	f := newTxnFixture(t, nil)
+	id := f.stage(t, time.Hour)
+	f.clock.Advance(DefaultLockDuration)
+
+	if _, err := f.shard.CommitTxn(&proto.TxnRequest{TxnId: id}); Code(err) != proto.ErrorCode_ERROR_TXN_ABORTED {
+		t.Fatalf("CommitTxn with a lapsed lock = %v, want ERROR_TXN_ABORTED", err)
+	}
+	if n := f.b.Len(); n != 1 {
+		t.Errorf("queue b holds %d messages, want 1", n)
+	}
+}
+
+func TestCommitTxnAcksTheLockedCopy(t *testing.T) {
+	s := NewShard(WithShardClock(NewFakeClock(epoch)))
+	q := s.AddQueue(&proto.KokaqQueueRequest{Namespace: "ns", Queue: "q"})
+	mustEnqueue(t, q, msg("m", 0), msg("m", 0))
+	resp, err := q.PeekLock(context.Background(), &proto.PeekLockRequest{MaxCount: 2})
+	if err != nil || len(resp.GetLocked()) != 2 {
+		t.Fatalf("PeekLock = %v, %v", resp, err)
+	}
+	first, second := resp.GetLocked()[0].GetLockId(), resp.GetLocked()[1].GetLockId()
+	begin, err := s.BeginTxn(&proto.BeginTxnRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Ack(&proto.AckRequest{Namespace: "ns", Queue: "q", MessageId: "m", LockId: second, TxnId: begin.GetTxnId()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CommitTxn(&proto.TxnRequest{TxnId: begin.GetTxnId()}); err != nil {
+		t.Fatalf("CommitTxn: %v", err)
+	}
+	// The copy locked under first is the one left.
+	if _, err := q.Ack(&proto.AckRequest{MessageId: "m", LockId: first}); err != nil {
+		t.Errorf("Ack of the first copy: %v", err)
+	}
+}